@@ -0,0 +1,235 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// G711SampleRate is the sample rate of the G.711 telephony audio
+const G711SampleRate = 8000
+
+const (
+	wavFormatALaw = 6
+	wavFormatULaw = 7
+
+	g711ULawBias = 0x84
+	g711ULawClip = 8159
+)
+
+var (
+	g711ULawSegments = [...]int{0x3f, 0x7f, 0xff, 0x1ff, 0x3ff, 0x7ff, 0xfff, 0x1fff}
+	g711ALawSegments = [...]int{0x1f, 0x3f, 0x7f, 0xff, 0x1ff, 0x3ff, 0x7ff, 0xfff}
+)
+
+// G711 is the companding law of the G.711 telephony codec
+type G711 int
+
+const (
+	G711ULaw G711 = iota + 1
+	G711ALaw
+)
+
+// g711Encoder encodes the lpcm read from r with the one byte per sample
+type g711Encoder struct {
+	r     io.Reader
+	codec G711
+	buf   []byte
+	carry []byte
+}
+
+// Encode compresses the 16-bit sample into the 8-bit code
+func (c G711) Encode(sample int16) byte {
+	if c == G711ALaw {
+		return encodeALaw(sample)
+	}
+
+	return encodeULaw(sample)
+}
+
+// Decode expands the 8-bit code into the 16-bit sample
+func (c G711) Decode(code byte) int16 {
+	if c == G711ALaw {
+		return decodeALaw(code)
+	}
+
+	return decodeULaw(code)
+}
+
+// WAVFormat returns the format of the 8 kHz mono G.711 audio in the wav container
+func (c G711) WAVFormat() WAVFormat {
+	tag := uint16(wavFormatULaw)
+
+	if c == G711ALaw {
+		tag = wavFormatALaw
+	}
+
+	return WAVFormat{Tag: tag, SampleRate: G711SampleRate, Channels: 1, BitsPerSample: 8}
+}
+
+// EncodeG711 encodes the 16-bit little-endian lpcm samples, the trailing odd byte is ignored
+func EncodeG711(codec G711, samples []byte) []byte {
+	result := make([]byte, len(samples)/2)
+
+	for i := range result {
+		result[i] = codec.Encode(int16(binary.LittleEndian.Uint16(samples[i*2:])))
+	}
+
+	return result
+}
+
+// DecodeG711 decodes the codes into the 16-bit little-endian lpcm samples
+func DecodeG711(codec G711, codes []byte) []byte {
+	result := make([]byte, len(codes)*2)
+
+	for i, code := range codes {
+		binary.LittleEndian.PutUint16(result[i*2:], uint16(codec.Decode(code)))
+	}
+
+	return result
+}
+
+// NewG711Encoder returns the stream of the lpcm read from r encoded on the fly,
+// the sample rate of the lpcm has to be G711SampleRate
+func NewG711Encoder(r io.Reader, codec G711) io.Reader {
+	return &g711Encoder{r: r, codec: codec}
+}
+
+func (e *g711Encoder) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	for {
+		if cap(e.buf) < len(p)*2 {
+			e.buf = make([]byte, len(p)*2)
+		}
+
+		buf := append(e.buf[:0], e.carry...)
+		n, err := e.r.Read(buf[len(buf) : len(p)*2])
+		buf = buf[:len(buf)+n]
+		count := copy(p, EncodeG711(e.codec, buf))
+		e.carry = append(e.carry[:0], buf[count*2:]...)
+
+		if count > 0 || err != nil {
+			return count, err
+		}
+	}
+}
+
+func encodeULaw(sample int16) byte {
+	var (
+		value = int(sample) >> 2
+		mask  = 0xff
+	)
+
+	if value < 0 {
+		value, mask = -value, 0x7f
+	}
+
+	if value > g711ULawClip {
+		value = g711ULawClip
+	}
+
+	value += g711ULawBias >> 2
+	segment := g711Segment(value, g711ULawSegments[:])
+
+	if segment >= len(g711ULawSegments) {
+		return byte(0x7f ^ mask)
+	}
+
+	return byte((segment<<4 | (value>>(segment+1))&0x0f) ^ mask)
+}
+
+func decodeULaw(code byte) int16 {
+	code = ^code
+	value := (int(code&0x0f)<<3 + g711ULawBias) << ((code & 0x70) >> 4)
+
+	if code&0x80 != 0 {
+		return int16(g711ULawBias - value)
+	}
+
+	return int16(value - g711ULawBias)
+}
+
+func encodeALaw(sample int16) byte {
+	var (
+		value = int(sample) >> 3
+		mask  = 0xd5
+	)
+
+	if value < 0 {
+		value, mask = -value-1, 0x55
+	}
+
+	segment := g711Segment(value, g711ALawSegments[:])
+
+	if segment >= len(g711ALawSegments) {
+		return byte(0x7f ^ mask)
+	}
+
+	code := segment << 4
+
+	if segment < 2 {
+		code |= (value >> 1) & 0x0f
+	} else {
+		code |= (value >> segment) & 0x0f
+	}
+
+	return byte(code ^ mask)
+}
+
+func decodeALaw(code byte) int16 {
+	code ^= 0x55
+
+	var (
+		value   = int(code&0x0f) << 4
+		segment = int(code&0x70) >> 4
+	)
+
+	switch segment {
+	case 0:
+		value += 8
+	case 1:
+		value += 0x108
+	default:
+		value = (value + 0x108) << (segment - 1)
+	}
+
+	if code&0x80 != 0 {
+		return int16(value)
+	}
+
+	return int16(-value)
+}
+
+// g711Segment returns the index of the first segment end not below the value
+func g711Segment(value int, ends []int) int {
+	for i, end := range ends {
+		if value <= end {
+			return i
+		}
+	}
+
+	return len(ends)
+}
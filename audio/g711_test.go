@@ -0,0 +1,97 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math"
+	"testing"
+	"testing/iotest"
+)
+
+func TestG711_Encode(t *testing.T) {
+	tests := []struct {
+		codec    G711
+		sample   int16
+		expected byte
+	}{
+		{codec: G711ULaw, sample: 0, expected: 0xff},
+		{codec: G711ULaw, sample: -1, expected: 0x7e},
+		{codec: G711ULaw, sample: 32767, expected: 0x80},
+		{codec: G711ULaw, sample: -32768, expected: 0x00},
+		{codec: G711ALaw, sample: 0, expected: 0xd5},
+		{codec: G711ALaw, sample: -1, expected: 0x55},
+		{codec: G711ALaw, sample: 32767, expected: 0xaa},
+		{codec: G711ALaw, sample: -32768, expected: 0x2a},
+	}
+
+	for _, entry := range tests {
+		assert.Equal(t, entry.expected, entry.codec.Encode(entry.sample), "%d %d", entry.codec, entry.sample)
+	}
+}
+
+func TestG711_Decode(t *testing.T) {
+	assert.Equal(t, int16(0), G711ULaw.Decode(0xff))
+	assert.Equal(t, int16(-32124), G711ULaw.Decode(0x00))
+	assert.Equal(t, int16(32124), G711ULaw.Decode(0x80))
+	assert.Equal(t, int16(8), G711ALaw.Decode(0xd5))
+	assert.Equal(t, int16(-8), G711ALaw.Decode(0x55))
+	assert.Equal(t, int16(32256), G711ALaw.Decode(0xaa))
+
+	// the quantization error grows with the magnitude, but stays within the segment step
+	for _, codec := range []G711{G711ULaw, G711ALaw} {
+		for sample := math.MinInt16; sample <= math.MaxInt16; sample += 7 {
+			decoded := float64(codec.Decode(codec.Encode(int16(sample))))
+
+			assert.InDelta(t, float64(sample), decoded, math.Max(64, math.Abs(float64(sample))/16))
+		}
+	}
+}
+
+func TestG711_WAVFormat(t *testing.T) {
+	header := G711ALaw.WAVFormat().Header(8000)
+
+	assert.Equal(t, uint16(6), binary.LittleEndian.Uint16(header[20:]))
+	assert.Equal(t, uint32(8000), binary.LittleEndian.Uint32(header[24:]))
+	assert.Equal(t, uint32(8000), binary.LittleEndian.Uint32(header[28:]))
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(header[32:]))
+	assert.Equal(t, uint16(8), binary.LittleEndian.Uint16(header[34:]))
+	assert.Equal(t, uint16(7), G711ULaw.WAVFormat().Tag)
+}
+
+func TestNewG711Encoder(t *testing.T) {
+	samples := make([]byte, 1001)
+
+	for i := 0; i < 500; i++ {
+		binary.LittleEndian.PutUint16(samples[i*2:], uint16(int16(i*131-32000)))
+	}
+
+	data, err := ioutil.ReadAll(NewG711Encoder(iotest.OneByteReader(bytes.NewReader(samples)), G711ULaw))
+
+	assert.NoError(t, err)
+	assert.Equal(t, EncodeG711(G711ULaw, samples), data)
+	assert.Len(t, data, 500)
+	assert.Equal(t, make([]byte, 4), DecodeG711(G711ULaw, EncodeG711(G711ULaw, []byte{0, 0, 0, 0})))
+}
@@ -0,0 +1,119 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oggopus
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var ErrNotOpus = errors.New("not an ogg opus stream")
+
+// SampleRate is the rate of the opus granule positions regardless of the input rate
+const SampleRate = 48000
+
+const headSize = 19
+
+// Head is the identification header of the opus stream
+type Head struct {
+	Version         byte
+	Channels        byte
+	PreSkip         uint16
+	InputSampleRate uint32
+	OutputGain      int16
+	MappingFamily   byte
+	// Mapping is the channel mapping table of the non-zero mapping family
+	Mapping []byte
+}
+
+// ParseHead parses the OpusHead packet
+func ParseHead(packet []byte) (Head, error) {
+	if len(packet) < headSize || string(packet[:8]) != "OpusHead" || packet[8]>>4 != 0 {
+		return Head{}, ErrNotOpus
+	}
+
+	return Head{
+		Version:         packet[8],
+		Channels:        packet[9],
+		PreSkip:         binary.LittleEndian.Uint16(packet[10:]),
+		InputSampleRate: binary.LittleEndian.Uint32(packet[12:]),
+		OutputGain:      int16(binary.LittleEndian.Uint16(packet[16:])),
+		MappingFamily:   packet[18],
+		Mapping:         append([]byte(nil), packet[headSize:]...),
+	}, nil
+}
+
+// Marshal returns the OpusHead packet
+func (h Head) Marshal() []byte {
+	result := make([]byte, headSize, headSize+len(h.Mapping))
+
+	copy(result, "OpusHead")
+	result[8] = h.Version
+	result[9] = h.Channels
+	binary.LittleEndian.PutUint16(result[10:], h.PreSkip)
+	binary.LittleEndian.PutUint32(result[12:], h.InputSampleRate)
+	binary.LittleEndian.PutUint16(result[16:], uint16(h.OutputGain))
+	result[18] = h.MappingFamily
+
+	return append(result, h.Mapping...)
+}
+
+// PacketSamples returns the number of the 48 kHz samples of the opus packet by its TOC byte,
+// zero is returned for the malformed packet
+func PacketSamples(packet []byte) int {
+	if len(packet) == 0 {
+		return 0
+	}
+
+	var (
+		config = int(packet[0] >> 3)
+		frame  int
+		frames int
+	)
+
+	switch {
+	case config < 12:
+		// SILK 10, 20, 40 and 60 ms
+		frame = []int{480, 960, 1920, 2880}[config%4]
+	case config < 16:
+		// hybrid 10 and 20 ms
+		frame = []int{480, 960}[config%2]
+	default:
+		// CELT 2.5, 5, 10 and 20 ms
+		frame = []int{120, 240, 480, 960}[config%4]
+	}
+
+	switch packet[0] & 0x03 {
+	case 0:
+		frames = 1
+	case 1, 2:
+		frames = 2
+	default:
+		if len(packet) < 2 {
+			return 0
+		}
+
+		frames = int(packet[1] & 0x3f)
+	}
+
+	return frame * frames
+}
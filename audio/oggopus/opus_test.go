@@ -0,0 +1,61 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oggopus
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseHead(t *testing.T) {
+	head := Head{Version: 1, Channels: 1, PreSkip: 312, InputSampleRate: 48000, OutputGain: -256}
+	parsed, err := ParseHead(head.Marshal())
+
+	assert.NoError(t, err)
+	assert.Equal(t, head.PreSkip, parsed.PreSkip)
+	assert.Equal(t, head.OutputGain, parsed.OutputGain)
+	assert.Equal(t, head.InputSampleRate, parsed.InputSampleRate)
+
+	_, err = ParseHead([]byte("OpusTags"))
+	assert.ErrorIs(t, err, ErrNotOpus)
+}
+
+func TestPacketSamples(t *testing.T) {
+	tests := []struct {
+		packet   []byte
+		expected int
+	}{
+		{packet: []byte{0 << 3}, expected: 480},
+		{packet: []byte{3 << 3}, expected: 2880},
+		{packet: []byte{1<<3 | 1}, expected: 1920},
+		{packet: []byte{13 << 3}, expected: 960},
+		{packet: []byte{16 << 3}, expected: 120},
+		{packet: []byte{31 << 3}, expected: 960},
+		{packet: []byte{31<<3 | 3, 3}, expected: 2880},
+		{packet: []byte{31<<3 | 3}, expected: 0},
+		{packet: nil, expected: 0},
+	}
+
+	for _, entry := range tests {
+		assert.Equal(t, entry.expected, PacketSamples(entry.packet), "% x", entry.packet)
+	}
+}
@@ -0,0 +1,222 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package oggopus parses, measures and joins the Ogg Opus streams without decoding them
+package oggopus
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var (
+	ErrInvalidPage = errors.New("invalid ogg page")
+	ErrInvalidCRC  = errors.New("ogg page checksum mismatch")
+)
+
+const (
+	// HeaderContinued marks the page starting with the continuation of the previous packet
+	HeaderContinued = 0x01
+	// HeaderBOS marks the first page of the logical stream
+	HeaderBOS = 0x02
+	// HeaderEOS marks the last page of the logical stream
+	HeaderEOS = 0x04
+
+	// NoGranule is the granule position of the page without a finished packet
+	NoGranule = -1
+
+	pageHeaderSize = 27
+	maxSegments    = 255
+)
+
+// crcTable is the table of the ogg crc32 with the 0x04c11db7 polynomial, no reflection and no final xor
+var crcTable = func() [256]uint32 {
+	var table [256]uint32
+
+	for i := range table {
+		r := uint32(i) << 24
+
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = r<<1 ^ 0x04c11db7
+			} else {
+				r <<= 1
+			}
+		}
+
+		table[i] = r
+	}
+
+	return table
+}()
+
+// Page is the single page of the ogg physical stream
+type Page struct {
+	HeaderType byte
+	Granule    int64
+	Serial     uint32
+	Sequence   uint32
+	// Segments is the lacing table, a segment shorter than 255 bytes ends the packet
+	Segments []byte
+	Data     []byte
+}
+
+// ReadPage reads the next page and verifies its checksum, io.EOF is returned at the end of the stream
+func ReadPage(r io.Reader) (*Page, error) {
+	header := make([]byte, pageHeaderSize)
+
+	if _, err := io.ReadFull(r, header); err == io.ErrUnexpectedEOF {
+		return nil, ErrInvalidPage
+	} else if err != nil {
+		return nil, err
+	} else if string(header[:4]) != "OggS" || header[4] != 0 {
+		return nil, ErrInvalidPage
+	}
+
+	page := &Page{
+		HeaderType: header[5],
+		Granule:    int64(binary.LittleEndian.Uint64(header[6:])),
+		Serial:     binary.LittleEndian.Uint32(header[14:]),
+		Sequence:   binary.LittleEndian.Uint32(header[18:]),
+		Segments:   make([]byte, header[26]),
+	}
+
+	if _, err := io.ReadFull(r, page.Segments); err != nil {
+		return nil, ErrInvalidPage
+	}
+
+	size := 0
+
+	for _, segment := range page.Segments {
+		size += int(segment)
+	}
+
+	page.Data = make([]byte, size)
+
+	if _, err := io.ReadFull(r, page.Data); err != nil {
+		return nil, ErrInvalidPage
+	}
+
+	if binary.LittleEndian.Uint32(header[22:]) != binary.LittleEndian.Uint32(page.Marshal()[22:]) {
+		return nil, ErrInvalidCRC
+	}
+
+	return page, nil
+}
+
+// Marshal returns the page bytes with the checksum
+func (p *Page) Marshal() []byte {
+	result := make([]byte, pageHeaderSize+len(p.Segments)+len(p.Data))
+
+	copy(result, "OggS")
+	result[5] = p.HeaderType
+	binary.LittleEndian.PutUint64(result[6:], uint64(p.Granule))
+	binary.LittleEndian.PutUint32(result[14:], p.Serial)
+	binary.LittleEndian.PutUint32(result[18:], p.Sequence)
+	result[26] = byte(len(p.Segments))
+	copy(result[pageHeaderSize:], p.Segments)
+	copy(result[pageHeaderSize+len(p.Segments):], p.Data)
+
+	binary.LittleEndian.PutUint32(result[22:], checksum(result))
+
+	return result
+}
+
+// checksum returns the ogg crc32 of the data
+func checksum(data []byte) uint32 {
+	var crc uint32
+
+	for _, b := range data {
+		crc = crc<<8 ^ crcTable[byte(crc>>24)^b]
+	}
+
+	return crc
+}
+
+// Packets returns the parts of the packets on the page, the last part is unfinished
+// when the page ends with the 255 bytes segment
+func (p *Page) Packets() (parts [][]byte, lastFinished bool) {
+	var start, offset int
+
+	for i, segment := range p.Segments {
+		offset += int(segment)
+
+		if segment < maxSegments {
+			parts = append(parts, p.Data[start:offset])
+			start = offset
+		} else if i == len(p.Segments)-1 {
+			parts = append(parts, p.Data[start:offset])
+
+			return parts, false
+		}
+	}
+
+	return parts, true
+}
+
+// NewPages splits the packet into the pages of the logical stream starting with the sequence,
+// the granule is set on the last page and the others have NoGranule
+func NewPages(packet []byte, serial, sequence uint32, granule int64) []*Page {
+	var (
+		result   []*Page
+		segments = make([]byte, 0, len(packet)/maxSegments+1)
+	)
+
+	for rest := len(packet); ; rest -= maxSegments {
+		if rest < maxSegments {
+			segments = append(segments, byte(rest))
+
+			break
+		}
+
+		segments = append(segments, maxSegments)
+	}
+
+	for offset := 0; len(segments) > 0; sequence++ {
+		count := len(segments)
+
+		if count > maxSegments {
+			count = maxSegments
+		}
+
+		page := &Page{Granule: NoGranule, Serial: serial, Sequence: sequence, Segments: segments[:count]}
+
+		if offset > 0 {
+			page.HeaderType = HeaderContinued
+		}
+
+		size := 0
+
+		for _, segment := range page.Segments {
+			size += int(segment)
+		}
+
+		page.Data = packet[offset : offset+size]
+		offset += size
+		segments = segments[count:]
+		result = append(result, page)
+	}
+
+	result[len(result)-1].Granule = granule
+
+	return result
+}
@@ -0,0 +1,87 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oggopus
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestChecksum(t *testing.T) {
+	// the ogg crc is the POSIX cksum without the final inversion
+	assert.Equal(t, uint32(0x89a1897f), checksum([]byte("123456789")))
+}
+
+func TestReadPage(t *testing.T) {
+	page := &Page{
+		HeaderType: HeaderBOS,
+		Granule:    12345,
+		Serial:     7,
+		Sequence:   3,
+		Segments:   []byte{255, 10, 2},
+		Data:       bytes.Repeat([]byte{1}, 267),
+	}
+	data := page.Marshal()
+
+	read, err := ReadPage(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, page, read)
+
+	_, err = ReadPage(bytes.NewReader(nil))
+	assert.Equal(t, io.EOF, err)
+
+	_, err = ReadPage(bytes.NewReader(data[:40]))
+	assert.ErrorIs(t, err, ErrInvalidPage)
+
+	data[100]++
+	_, err = ReadPage(bytes.NewReader(data))
+	assert.ErrorIs(t, err, ErrInvalidCRC)
+}
+
+func TestPage_Packets(t *testing.T) {
+	page := &Page{Segments: []byte{3, 255, 255, 0, 255}, Data: make([]byte, 768)}
+	parts, finished := page.Packets()
+
+	assert.False(t, finished)
+	assert.Len(t, parts, 3)
+	assert.Len(t, parts[0], 3)
+	assert.Len(t, parts[1], 510)
+	assert.Len(t, parts[2], 255)
+}
+
+func TestNewPages(t *testing.T) {
+	pages := NewPages(make([]byte, 70000), 1, 5, 960)
+
+	assert.Len(t, pages, 2)
+	assert.Len(t, pages[0].Segments, 255)
+	assert.Equal(t, int64(NoGranule), pages[0].Granule)
+	assert.Equal(t, byte(HeaderContinued), pages[1].HeaderType)
+	assert.Equal(t, uint32(6), pages[1].Sequence)
+	assert.Equal(t, int64(960), pages[1].Granule)
+	assert.Equal(t, 70000, len(pages[0].Data)+len(pages[1].Data))
+
+	pages = NewPages(make([]byte, 255), 1, 0, 0)
+
+	assert.Equal(t, []byte{255, 0}, pages[0].Segments)
+}
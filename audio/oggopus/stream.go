@@ -0,0 +1,436 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oggopus
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrChannelsMismatch = errors.New("opus streams have different channel counts")
+	ErrChainedStream    = errors.New("chained or multiplexed ogg streams are not supported")
+	ErrNoStreams        = errors.New("no streams to concatenate")
+	ErrSilenceMapping   = errors.New("silence is only supported for the mono and stereo streams")
+)
+
+const (
+	// opusHeaders is the number of the header packets, OpusHead and OpusTags
+	opusHeaders = 2
+	// silenceFrame is the duration of the silence packet, silencePackets is the number of them per page
+	silenceFrame   = 20 * time.Millisecond
+	silencePackets = 50
+)
+
+// silence is the 20 ms fullband CELT frame decoded as silence, the TOC byte is set for the channels
+var silence = []byte{0xf8, 0xff, 0xfe}
+
+type (
+	// Info is the summary of the Ogg Opus stream
+	Info struct {
+		// Head is the identification header of the first logical stream
+		Head Head
+		// Granule is the last granule position of the last logical stream
+		Granule int64
+		// Duration is the playback duration of all the chained logical streams without the pre-skip
+		Duration time.Duration
+		Pages    int
+	}
+
+	// Joiner joins the Ogg Opus streams one by one into a single logical stream,
+	// the last page is held back to mark the end of the stream on Close
+	Joiner struct {
+		w        io.Writer
+		serial   uint32
+		sequence uint32
+		head     Head
+		// samples is the granule position of the joined stream
+		samples int64
+		pending *Page
+		// trimmed is the granule of the pending page with the end trimming of its source stream
+		trimmed int64
+	}
+
+	// packetReader tracks the packets of the pages of a single logical stream
+	packetReader struct {
+		packets int
+		// toc holds the first bytes of the unfinished packet
+		toc []byte
+	}
+)
+
+// Probe reads the stream to the end and returns its summary, the duration is calculated
+// from the granule positions and the pre-skip of every chained logical stream
+func Probe(r io.Reader) (Info, error) {
+	var (
+		info    Info
+		serial  uint32
+		preSkip int64
+		granule int64
+		started bool
+	)
+
+	finish := func() {
+		if started && granule > preSkip {
+			info.Duration += time.Duration((granule - preSkip) * int64(time.Second) / SampleRate)
+		}
+	}
+
+	for {
+		page, err := ReadPage(r)
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return info, err
+		}
+
+		info.Pages++
+
+		if page.HeaderType&HeaderBOS != 0 {
+			parts, _ := page.Packets()
+
+			if len(parts) == 0 {
+				return info, ErrNotOpus
+			}
+
+			head, err := ParseHead(parts[0])
+
+			if err != nil {
+				return info, err
+			}
+
+			finish()
+
+			if !started {
+				info.Head = head
+			}
+
+			serial, preSkip, granule, started = page.Serial, int64(head.PreSkip), 0, true
+		} else if !started {
+			return info, ErrNotOpus
+		} else if page.Serial != serial {
+			return info, ErrChainedStream
+		}
+
+		if page.Granule > granule {
+			granule = page.Granule
+		}
+	}
+
+	if !started {
+		return info, ErrNotOpus
+	}
+
+	finish()
+	info.Granule = granule
+
+	return info, nil
+}
+
+// Duration returns the playback duration of the stream
+func Duration(r io.Reader) (time.Duration, error) {
+	info, err := Probe(r)
+
+	return info.Duration, err
+}
+
+// Concat joins the Ogg Opus streams into a single logical stream written to w. The headers of the first
+// stream are kept, the pages of the others are renumbered into its serial and their granule positions continue
+// the samples of the previous streams. The pre-skip of the following streams can not be removed without
+// decoding, so their encoder delay of a few milliseconds is played.
+func Concat(w io.Writer, streams ...io.Reader) error {
+	if len(streams) == 0 {
+		return ErrNoStreams
+	}
+
+	j := NewJoiner(w)
+
+	for _, stream := range streams {
+		if err := j.Add(stream); err != nil {
+			return err
+		}
+	}
+
+	return j.Close()
+}
+
+// Silence writes the mono or stereo Ogg Opus stream of the silence of the given duration rounded to 20 ms
+func Silence(w io.Writer, channels byte, d time.Duration) error {
+	if channels == 0 || channels > 2 {
+		return ErrSilenceMapping
+	}
+
+	j := NewJoiner(w)
+	j.serial, j.head = 1, Head{Version: 1, Channels: channels, InputSampleRate: SampleRate}
+
+	for i, packet := range [][]byte{j.head.Marshal(), Comments{Vendor: "yatts"}.Marshal()} {
+		page := NewPages(packet, j.serial, j.sequence, 0)[0]
+
+		if i == 0 {
+			page.HeaderType = HeaderBOS
+		}
+
+		if err := j.write(page, 0); err != nil {
+			return err
+		}
+	}
+
+	if err := j.AddSilence(d); err != nil {
+		return err
+	}
+
+	return j.Close()
+}
+
+// NewJoiner creates the Joiner of the streams written to w, see Concat
+func NewJoiner(w io.Writer) *Joiner {
+	return &Joiner{w: w}
+}
+
+// Add appends the stream, the headers of the first one are kept
+func (j *Joiner) Add(r io.Reader) error {
+	return j.add(r, !j.started())
+}
+
+// AddSilence appends the silence packets of the given duration rounded to 20 ms,
+// the silence follows the added streams and takes their channel count
+func (j *Joiner) AddSilence(d time.Duration) error {
+	if !j.started() {
+		return ErrNoStreams
+	} else if j.head.MappingFamily != 0 {
+		return ErrSilenceMapping
+	}
+
+	packet := append([]byte(nil), silence...)
+
+	if j.head.Channels > 1 {
+		packet[0] |= 0x04
+	}
+
+	for count := int((d + silenceFrame/2) / silenceFrame); count > 0; count -= silencePackets {
+		page := &Page{Granule: NoGranule}
+
+		for i := 0; i < count && i < silencePackets; i++ {
+			j.samples += int64(PacketSamples(packet))
+			page.Segments = append(page.Segments, byte(len(packet)))
+			page.Data = append(page.Data, packet...)
+		}
+
+		page.Granule = j.samples
+
+		if err := j.write(page, NoGranule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Duration returns the playback duration of the joined stream without the pre-skip of the first stream
+func (j *Joiner) Duration() time.Duration {
+	if samples := j.samples - int64(j.head.PreSkip); samples > 0 {
+		return time.Duration(samples * int64(time.Second) / SampleRate)
+	}
+
+	return 0
+}
+
+// Close writes the held back last page with the end of the stream flag, w is not closed
+func (j *Joiner) Close() error {
+	return j.finish()
+}
+
+// started reports whether the headers of the first stream are written
+func (j *Joiner) started() bool {
+	return j.sequence > 0
+}
+
+// add appends the audio pages of the stream, the headers are written for the first one
+func (j *Joiner) add(r io.Reader, first bool) error {
+	var (
+		packets    packetReader
+		offset     = j.samples
+		serial     uint32
+		started    bool
+		identified bool
+	)
+
+	for {
+		page, err := ReadPage(r)
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if !started {
+			if page.HeaderType&HeaderBOS == 0 {
+				return ErrNotOpus
+			}
+
+			serial, started = page.Serial, true
+		} else if page.Serial != serial || page.HeaderType&HeaderBOS != 0 {
+			return ErrChainedStream
+		}
+
+		header := packets.packets < opusHeaders
+		finished, samples, head := packets.read(page)
+
+		if head == nil && !identified && packets.packets > 0 {
+			return ErrNotOpus
+		} else if head != nil {
+			identified = true
+
+			if first {
+				j.serial, j.head = page.Serial, *head
+			} else if head.Channels != j.head.Channels {
+				return ErrChannelsMismatch
+			}
+		}
+
+		if header {
+			if first {
+				if err = j.write(&Page{
+					HeaderType: page.HeaderType &^ HeaderEOS,
+					Granule:    0,
+					Segments:   page.Segments,
+					Data:       page.Data,
+				}, 0); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		j.samples += samples
+		out := &Page{
+			HeaderType: page.HeaderType &^ (HeaderBOS | HeaderEOS),
+			Granule:    NoGranule,
+			Segments:   page.Segments,
+			Data:       page.Data,
+		}
+		trimmed := int64(NoGranule)
+
+		if finished > 0 {
+			out.Granule = j.samples
+
+			if page.Granule >= 0 {
+				trimmed = offset + page.Granule
+			}
+		}
+
+		if err = j.write(out, trimmed); err != nil {
+			return err
+		}
+	}
+
+	if !started {
+		return ErrNotOpus
+	}
+
+	return nil
+}
+
+// write writes the pending page and holds back the given one
+func (j *Joiner) write(page *Page, trimmed int64) error {
+	page.Serial = j.serial
+	page.Sequence = j.sequence
+	j.sequence++
+
+	if err := j.flush(); err != nil {
+		return err
+	}
+
+	j.pending, j.trimmed = page, trimmed
+
+	return nil
+}
+
+// finish writes the last page with the end of the stream flag and the end trimming of the last stream
+func (j *Joiner) finish() error {
+	if j.pending == nil {
+		return nil
+	}
+
+	j.pending.HeaderType |= HeaderEOS
+
+	if j.trimmed >= 0 && j.trimmed < j.pending.Granule {
+		j.pending.Granule = j.trimmed
+	}
+
+	return j.flush()
+}
+
+func (j *Joiner) flush() error {
+	if j.pending == nil {
+		return nil
+	}
+
+	_, err := j.w.Write(j.pending.Marshal())
+	j.pending = nil
+
+	return err
+}
+
+// read counts the packets finished on the page and the samples of the audio ones,
+// the identification header is returned when the page finishes it
+func (p *packetReader) read(page *Page) (finished int, samples int64, head *Head) {
+	parts, lastFinished := page.Packets()
+
+	for i, part := range parts {
+		if i > 0 || page.HeaderType&HeaderContinued == 0 {
+			p.toc = p.toc[:0]
+		}
+
+		if p.packets == 0 {
+			// the identification header is kept whole
+			p.toc = append(p.toc, part...)
+		} else if rest := 2 - len(p.toc); rest > 0 {
+			if rest > len(part) {
+				rest = len(part)
+			}
+
+			p.toc = append(p.toc, part[:rest]...)
+		}
+
+		if i == len(parts)-1 && !lastFinished {
+			break
+		}
+
+		if p.packets == 0 {
+			if parsed, err := ParseHead(p.toc); err == nil {
+				head = &parsed
+			}
+		} else if p.packets >= opusHeaders {
+			samples += int64(PacketSamples(p.toc))
+		}
+
+		p.packets++
+		finished++
+	}
+
+	return finished, samples, head
+}
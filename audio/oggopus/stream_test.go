@@ -0,0 +1,218 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oggopus
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+// celt20ms is the TOC byte of the 20 ms fullband CELT packet
+const celt20ms = 31 << 3
+
+// testStream returns the Ogg Opus stream of the 20 ms packets of the given sizes,
+// the last granule position is reduced by the end trimming
+func testStream(serial uint32, preSkip uint16, sizes []int, trim int64) []byte {
+	var (
+		buf      bytes.Buffer
+		sequence uint32
+		granule  int64
+		tags     = append([]byte("OpusTags"), 4, 0, 0, 0, 't', 'e', 's', 't', 0, 0, 0, 0)
+	)
+
+	write := func(pages []*Page) {
+		for _, page := range pages {
+			sequence++
+			buf.Write(page.Marshal())
+		}
+	}
+
+	head := NewPages(Head{Version: 1, Channels: 1, PreSkip: preSkip, InputSampleRate: 48000}.Marshal(), serial, 0, 0)
+	head[0].HeaderType = HeaderBOS
+	write(head)
+	write(NewPages(tags, serial, sequence, 0))
+
+	for i, size := range sizes {
+		packet := make([]byte, size)
+		packet[0] = celt20ms
+		granule += 960
+		pages := NewPages(packet, serial, sequence, granule)
+
+		if i == len(sizes)-1 {
+			pages[len(pages)-1].Granule -= trim
+			pages[len(pages)-1].HeaderType |= HeaderEOS
+		}
+
+		write(pages)
+	}
+
+	return buf.Bytes()
+}
+
+func readPages(t *testing.T, data []byte) []*Page {
+	var (
+		r      = bytes.NewReader(data)
+		result []*Page
+	)
+
+	for {
+		page, err := ReadPage(r)
+
+		if err == io.EOF {
+			return result
+		}
+
+		assert.NoError(t, err)
+		result = append(result, page)
+	}
+}
+
+func TestProbe(t *testing.T) {
+	// 50 packets of 20 ms without the pre-skip of 312 samples and the trimming of 648 samples
+	info, err := Probe(bytes.NewReader(testStream(1, 312, make50(100), 648)))
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(312), info.Head.PreSkip)
+	assert.Equal(t, int64(50*960-648), info.Granule)
+	assert.Equal(t, 52, info.Pages)
+	assert.Equal(t, time.Second-20*time.Millisecond, info.Duration)
+
+	// the chained streams are summed
+	chained := append(testStream(1, 0, make50(10), 0), testStream(2, 0, make50(10), 0)...)
+	d, err := Duration(bytes.NewReader(chained))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2*time.Second, d)
+
+	_, err = Probe(bytes.NewReader(nil))
+	assert.ErrorIs(t, err, ErrNotOpus)
+}
+
+func TestConcat(t *testing.T) {
+	var (
+		buf    bytes.Buffer
+		first  = testStream(10, 312, []int{100, 100}, 0)
+		second = testStream(20, 312, []int{70000, 100, 100}, 0)
+		third  = testStream(30, 312, []int{100}, 500)
+	)
+
+	assert.NoError(t, Concat(&buf, bytes.NewReader(first), bytes.NewReader(second), bytes.NewReader(third)))
+
+	pages := readPages(t, buf.Bytes())
+	// the head and the tags of the first stream, the audio pages of all of them
+	// and the second page of the packet spanning the pages
+	assert.Len(t, pages, 2+2+4+1)
+
+	var granules []int64
+
+	for i, page := range pages {
+		assert.Equal(t, uint32(10), page.Serial)
+		assert.Equal(t, uint32(i), page.Sequence)
+		assert.Equal(t, i == 0, page.HeaderType&HeaderBOS != 0)
+		assert.Equal(t, i == len(pages)-1, page.HeaderType&HeaderEOS != 0)
+		granules = append(granules, page.Granule)
+	}
+
+	assert.Equal(t, []int64{0, 0, 960, 1920, NoGranule, 2880, 3840, 4800, 5760 - 500}, granules)
+
+	info, err := Probe(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, pcmDuration(5760-500-312), info.Duration)
+}
+
+func TestConcat_Errors(t *testing.T) {
+	stereo := testStream(2, 0, []int{10}, 0)
+	page, _ := ReadPage(bytes.NewReader(stereo))
+	// the channel count of the OpusHead on the first page
+	page.Data[9] = 2
+	stereo = append(page.Marshal(), stereo[len(page.Marshal()):]...)
+
+	assert.ErrorIs(t, Concat(ioutil.Discard, bytes.NewReader(testStream(1, 0, []int{10}, 0)), bytes.NewReader(stereo)), ErrChannelsMismatch)
+	assert.ErrorIs(t, Concat(ioutil.Discard), ErrNoStreams)
+	assert.ErrorIs(t, Concat(ioutil.Discard, bytes.NewReader([]byte("not an ogg stream at all, just text"))), ErrInvalidPage)
+}
+
+func TestJoiner(t *testing.T) {
+	var buf bytes.Buffer
+
+	j := NewJoiner(&buf)
+
+	assert.ErrorIs(t, j.AddSilence(time.Second), ErrNoStreams)
+	assert.NoError(t, j.Add(bytes.NewReader(testStream(10, 312, make50(100), 0))))
+	assert.Equal(t, pcmDuration(50*960-312), j.Duration())
+
+	// 1.5 s of the silence is written in two pages and 15 ms is rounded to a single packet
+	assert.NoError(t, j.AddSilence(1500*time.Millisecond))
+	assert.NoError(t, j.AddSilence(15*time.Millisecond))
+	assert.Equal(t, pcmDuration(126*960-312), j.Duration())
+
+	assert.NoError(t, j.Add(bytes.NewReader(testStream(20, 312, []int{100}, 0))))
+	assert.NoError(t, j.Close())
+
+	pages := readPages(t, buf.Bytes())
+	assert.Len(t, pages, 2+50+3+1)
+
+	silence := pages[52]
+	parts, _ := silence.Packets()
+	assert.Len(t, parts, 50)
+	assert.Equal(t, []byte{0xf8, 0xff, 0xfe}, parts[0])
+	assert.Equal(t, int64(100*960), silence.Granule)
+
+	info, err := Probe(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, j.Duration(), info.Duration)
+}
+
+func TestSilence(t *testing.T) {
+	var buf bytes.Buffer
+
+	assert.NoError(t, Silence(&buf, 2, 130*time.Millisecond))
+
+	info, err := Probe(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, byte(2), info.Head.Channels)
+	assert.Equal(t, 140*time.Millisecond, info.Duration)
+
+	pages := readPages(t, buf.Bytes())
+	parts, _ := pages[2].Packets()
+	assert.Equal(t, []byte{0xfc, 0xff, 0xfe}, parts[0])
+
+	assert.ErrorIs(t, Silence(ioutil.Discard, 3, time.Second), ErrSilenceMapping)
+}
+
+func make50(size int) []int {
+	result := make([]int, 50)
+
+	for i := range result {
+		result[i] = size
+	}
+
+	return result
+}
+
+func pcmDuration(samples int64) time.Duration {
+	return time.Duration(samples * int64(time.Second) / SampleRate)
+}
@@ -0,0 +1,231 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oggopus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/lEx0/yatts/audio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// taggedFields are the comment fields replaced by the tags
+var taggedFields = []string{"TITLE", "ARTIST", "ALBUM", "TRACKNUMBER", "TRACKTOTAL", "CHAPTER"}
+
+// Comments is the OpusTags header of the vorbis comments
+type Comments struct {
+	Vendor string
+	// Fields are the comments in the KEY=value form
+	Fields []string
+}
+
+// ParseComments parses the OpusTags packet
+func ParseComments(packet []byte) (Comments, error) {
+	var comments Comments
+
+	if len(packet) < 16 || string(packet[:8]) != "OpusTags" {
+		return comments, ErrNotOpus
+	}
+
+	data := packet[8:]
+	next := func() (string, bool) {
+		if len(data) < 4 {
+			return "", false
+		}
+
+		size := binary.LittleEndian.Uint32(data)
+
+		if uint64(size) > uint64(len(data)-4) {
+			return "", false
+		}
+
+		value := string(data[4 : 4+size])
+		data = data[4+size:]
+
+		return value, true
+	}
+
+	vendor, ok := next()
+
+	if !ok || len(data) < 4 {
+		return comments, ErrNotOpus
+	}
+
+	comments.Vendor = vendor
+	count := binary.LittleEndian.Uint32(data)
+	data = data[4:]
+
+	for i := uint32(0); i < count; i++ {
+		field, ok := next()
+
+		if !ok {
+			return comments, ErrNotOpus
+		}
+
+		comments.Fields = append(comments.Fields, field)
+	}
+
+	return comments, nil
+}
+
+// Marshal returns the OpusTags packet
+func (c Comments) Marshal() []byte {
+	result := []byte("OpusTags")
+	size := make([]byte, 4)
+	appendString := func(value string) {
+		binary.LittleEndian.PutUint32(size, uint32(len(value)))
+		result = append(append(result, size...), value...)
+	}
+
+	appendString(c.Vendor)
+	binary.LittleEndian.PutUint32(size, uint32(len(c.Fields)))
+	result = append(result, size...)
+
+	for _, field := range c.Fields {
+		appendString(field)
+	}
+
+	return result
+}
+
+// SetTags replaces the title, artist, album, track and chapter fields with the tags,
+// the chapters are written as the CHAPTERxxx and CHAPTERxxxNAME fields
+func (c *Comments) SetTags(tags audio.Tags) {
+	fields := c.Fields[:0]
+
+	for _, field := range c.Fields {
+		key := strings.ToUpper(strings.SplitN(field, "=", 2)[0])
+		tagged := false
+
+		for _, prefix := range taggedFields {
+			tagged = tagged || key == prefix || prefix == "CHAPTER" && strings.HasPrefix(key, prefix)
+		}
+
+		if !tagged {
+			fields = append(fields, field)
+		}
+	}
+
+	for _, field := range [][2]string{
+		{"TITLE", tags.Title},
+		{"ARTIST", tags.Artist},
+		{"ALBUM", tags.Album},
+		{"TRACKNUMBER", number(tags.Track)},
+		{"TRACKTOTAL", number(tags.TrackTotal)},
+	} {
+		if field[1] != "" {
+			fields = append(fields, field[0]+"="+field[1])
+		}
+	}
+
+	for i, chapter := range tags.Chapters {
+		start := chapter.Start.Round(time.Millisecond)
+		fields = append(fields,
+			fmt.Sprintf("CHAPTER%03d=%02d:%02d:%02d.%03d", i+1,
+				int(start.Hours()), int(start.Minutes())%60, int(start.Seconds())%60, start.Milliseconds()%1000,
+			),
+			fmt.Sprintf("CHAPTER%03dNAME=%s", i+1, chapter.Title),
+		)
+	}
+
+	c.Fields = fields
+}
+
+// SetTags copies the stream from r to w with the tags set in its OpusTags header,
+// the pages following the header are renumbered
+func SetTags(w io.Writer, r io.Reader, tags audio.Tags) error {
+	head, err := ReadPage(r)
+
+	if err == io.EOF {
+		return ErrNotOpus
+	} else if err != nil {
+		return err
+	} else if _, err = ParseHead(head.Data); err != nil {
+		return err
+	}
+
+	var packet []byte
+
+	for {
+		page, err := ReadPage(r)
+
+		if err == io.EOF {
+			return ErrNotOpus
+		} else if err != nil {
+			return err
+		} else if page.Serial != head.Serial {
+			return ErrChainedStream
+		}
+
+		packet = append(packet, page.Data...)
+
+		// the audio starts on a fresh page after the OpusTags header
+		if parts, finished := page.Packets(); len(parts) != 1 {
+			return ErrInvalidPage
+		} else if finished {
+			break
+		}
+	}
+
+	comments, err := ParseComments(packet)
+
+	if err != nil {
+		return err
+	}
+
+	comments.SetTags(tags)
+	pages := append([]*Page{head}, NewPages(comments.Marshal(), head.Serial, head.Sequence+1, 0)...)
+	sequence := pages[len(pages)-1].Sequence + 1
+
+	for {
+		for _, page := range pages {
+			if _, err = w.Write(page.Marshal()); err != nil {
+				return err
+			}
+		}
+
+		page, err := ReadPage(r)
+
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		} else if page.Serial == head.Serial {
+			page.Sequence = sequence
+			sequence++
+		}
+
+		pages = []*Page{page}
+	}
+}
+
+// number formats the positive number, zero is empty
+func number(value int) string {
+	if value <= 0 {
+		return ""
+	}
+
+	return strconv.Itoa(value)
+}
@@ -0,0 +1,89 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package oggopus
+
+import (
+	"bytes"
+	"github.com/lEx0/yatts/audio"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComments_SetTags(t *testing.T) {
+	comments := Comments{Vendor: "test", Fields: []string{"title=old", "ENCODER=yatts", "CHAPTER001=00:00:00.000"}}
+	comments.SetTags(audio.Tags{
+		Title:  "Глава",
+		Artist: "alena",
+		Track:  3,
+		Chapters: []audio.Chapter{
+			{Title: "Intro"},
+			{Title: "Main", Start: time.Hour + 2*time.Minute + 3*time.Second + 45*time.Millisecond},
+		},
+	})
+
+	assert.Equal(t, []string{
+		"ENCODER=yatts",
+		"TITLE=Глава",
+		"ARTIST=alena",
+		"TRACKNUMBER=3",
+		"CHAPTER001=00:00:00.000",
+		"CHAPTER001NAME=Intro",
+		"CHAPTER002=01:02:03.045",
+		"CHAPTER002NAME=Main",
+	}, comments.Fields)
+
+	parsed, err := ParseComments(comments.Marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, comments, parsed)
+
+	_, err = ParseComments([]byte("OpusHead"))
+	assert.ErrorIs(t, err, ErrNotOpus)
+}
+
+func TestSetTags(t *testing.T) {
+	var (
+		source = testStream(1, 312, make50(100), 0)
+		tagged bytes.Buffer
+	)
+
+	// the long title moves the audio pages
+	assert.NoError(t, SetTags(&tagged, bytes.NewReader(source), audio.Tags{Title: strings.Repeat("a", 70000)}))
+
+	pages := readPages(t, tagged.Bytes())
+	assert.Len(t, pages, len(readPages(t, source))+1)
+
+	for i, page := range pages {
+		assert.Equal(t, uint32(i), page.Sequence)
+	}
+
+	comments, err := ParseComments(append(pages[1].Data, pages[2].Data...))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TITLE=" + strings.Repeat("a", 70000)}, comments.Fields)
+
+	info, err := Probe(bytes.NewReader(tagged.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second-pcmDuration(312), info.Duration)
+
+	assert.ErrorIs(t, SetTags(&tagged, bytes.NewReader([]byte("not an ogg stream")), audio.Tags{}), ErrInvalidPage)
+}
@@ -0,0 +1,335 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audio
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+var (
+	ErrInvalidPaceConfig = errors.New("invalid pace config")
+	ErrReaderClosed      = errors.New("reader is closed")
+)
+
+// DefaultPaceFrameDuration is the length of the frame released by PacedReader when it is not set
+const DefaultPaceFrameDuration = 20 * time.Millisecond
+
+type (
+	// PaceConfig describes the paced stream and its buffering
+	PaceConfig struct {
+		SampleRate int
+		// SampleSize is 2 for the 16-bit lpcm and 1 for the G.711
+		SampleSize int
+		// Silence is the sample byte of the frames inserted on the underruns,
+		// 0 for the lpcm, 0xff for the µ-law and 0xd5 for the A-law
+		Silence byte
+		// FrameDuration is DefaultPaceFrameDuration when zero
+		FrameDuration time.Duration
+		// PreRoll is the audio buffered before the first frame is released
+		PreRoll time.Duration
+		// JitterBuffer is the most audio read ahead of the playback, it is at least the pre-roll
+		// and a single frame
+		JitterBuffer time.Duration
+	}
+
+	// PaceStats are the counters of the paced stream
+	PaceStats struct {
+		// Frames is the number of the released frames including the silent ones
+		Frames int
+		// SilentFrames is the number of the silent frames inserted on the underruns
+		SilentFrames int
+		// Underruns is the number of the times the jitter buffer ran empty before the end of the stream
+		Underruns int
+		// LateFrames is the number of the frames read after their release time
+		LateFrames int
+		// MaxLateness is the largest delay of the late frames
+		MaxLateness time.Duration
+	}
+
+	// PacedReader releases the fixed-duration frames of the stream on the wall-clock schedule,
+	// every Read blocks until the next frame is due and returns at most a single frame
+	PacedReader struct {
+		r         io.Reader
+		frame     time.Duration
+		frameSize int
+		silence   []byte
+		frames    chan []byte
+		preRolled chan struct{}
+		done      chan struct{}
+		closeOnce sync.Once
+
+		mu      sync.Mutex
+		stats   PaceStats
+		err     error
+		start   time.Time
+		pending []byte
+		// underrun is set while the silence is released instead of the late audio
+		underrun bool
+		ended    bool
+	}
+)
+
+// NewPacedReader starts reading r into the jitter buffer and returns the paced stream
+func NewPacedReader(r io.Reader, config PaceConfig) (*PacedReader, error) {
+	if config.SampleRate <= 0 || (config.SampleSize != 1 && config.SampleSize != 2) {
+		return nil, ErrInvalidPaceConfig
+	} else if config.PreRoll < 0 || config.JitterBuffer < 0 {
+		return nil, ErrInvalidPaceConfig
+	}
+
+	if config.FrameDuration <= 0 {
+		config.FrameDuration = DefaultPaceFrameDuration
+	}
+
+	samples := int(int64(config.FrameDuration) * int64(config.SampleRate) / int64(time.Second))
+
+	if samples == 0 {
+		return nil, ErrInvalidPaceConfig
+	}
+
+	var (
+		preRoll  = frameCount(config.PreRoll, config.FrameDuration)
+		capacity = frameCount(config.JitterBuffer, config.FrameDuration)
+	)
+
+	if capacity < preRoll {
+		capacity = preRoll
+	}
+
+	if capacity == 0 {
+		capacity = 1
+	}
+
+	p := &PacedReader{
+		r:         r,
+		frame:     config.FrameDuration,
+		frameSize: samples * config.SampleSize,
+		frames:    make(chan []byte, capacity),
+		preRolled: make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	p.silence = make([]byte, p.frameSize)
+
+	for i := range p.silence {
+		p.silence[i] = config.Silence
+	}
+
+	go p.fill(preRoll)
+
+	return p, nil
+}
+
+// Read waits for the release time of the next frame and returns it, the silence is returned
+// when the frame is not read from the source in time
+func (p *PacedReader) Read(b []byte) (int, error) {
+	select {
+	case <-p.done:
+		return 0, ErrReaderClosed
+	default:
+	}
+
+	p.mu.Lock()
+	pending := len(p.pending) > 0
+	p.mu.Unlock()
+
+	if !pending {
+		if err := p.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := copy(b, p.pending)
+	p.pending = p.pending[n:]
+
+	return n, nil
+}
+
+// Stats returns the counters of the frames released so far
+func (p *PacedReader) Stats() PaceStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.stats
+}
+
+// Close stops reading the source, the source itself is not closed
+func (p *PacedReader) Close() error {
+	p.closeOnce.Do(func() { close(p.done) })
+
+	return nil
+}
+
+// next waits for the release time of the next frame and makes it pending
+func (p *PacedReader) next() error {
+	p.mu.Lock()
+	ended, start, released := p.ended, p.start, p.stats.Frames
+	p.mu.Unlock()
+
+	if ended {
+		return p.endErr()
+	}
+
+	if start.IsZero() {
+		select {
+		case <-p.preRolled:
+		case <-p.done:
+			return ErrReaderClosed
+		}
+
+		start = time.Now()
+		p.mu.Lock()
+		p.start = start
+		p.mu.Unlock()
+	}
+
+	due := start.Add(time.Duration(released) * p.frame)
+	lateness := time.Since(due)
+
+	if lateness < 0 {
+		timer := time.NewTimer(-lateness)
+
+		select {
+		case <-timer.C:
+		case <-p.done:
+			timer.Stop()
+
+			return ErrReaderClosed
+		}
+	}
+
+	var (
+		frame []byte
+		ok    = true
+		empty bool
+	)
+
+	select {
+	case frame, ok = <-p.frames:
+	default:
+		empty = true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch {
+	case !ok:
+		p.ended = true
+
+		return p.endErrLocked()
+	case empty:
+		if !p.underrun {
+			p.stats.Underruns++
+		}
+
+		p.underrun = true
+		p.stats.SilentFrames++
+		frame = p.silence
+	default:
+		p.underrun = false
+	}
+
+	// the frame is late when the reader comes after the next frame is already due
+	if lateness > p.frame {
+		p.stats.LateFrames++
+
+		if lateness > p.stats.MaxLateness {
+			p.stats.MaxLateness = lateness
+		}
+	}
+
+	p.stats.Frames++
+	p.pending = append(p.pending[:0], frame...)
+
+	return nil
+}
+
+// fill reads the frames of the source into the jitter buffer
+func (p *PacedReader) fill(preRoll int) {
+	var (
+		sent      int
+		preRolled bool
+	)
+
+	markPreRolled := func() {
+		if !preRolled {
+			preRolled = true
+			close(p.preRolled)
+		}
+	}
+
+	defer close(p.frames)
+	defer markPreRolled()
+
+	for {
+		frame := make([]byte, p.frameSize)
+		n, err := io.ReadFull(p.r, frame)
+
+		if n > 0 {
+			select {
+			case p.frames <- frame[:n]:
+			case <-p.done:
+				return
+			}
+
+			if sent++; sent >= preRoll {
+				markPreRolled()
+			}
+		}
+
+		if err != nil {
+			if err != io.EOF && err != io.ErrUnexpectedEOF {
+				p.mu.Lock()
+				p.err = err
+				p.mu.Unlock()
+			}
+
+			return
+		}
+	}
+}
+
+func (p *PacedReader) endErr() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.endErrLocked()
+}
+
+func (p *PacedReader) endErrLocked() error {
+	if p.err != nil {
+		return p.err
+	}
+
+	return io.EOF
+}
+
+func frameCount(d, frame time.Duration) int {
+	return int((d + frame - 1) / frame)
+}
@@ -0,0 +1,160 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audio
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestPacedReader(t *testing.T) {
+	// 100ms of the µ-law audio released by 10ms frames
+	p, err := NewPacedReader(bytes.NewReader(bytes.Repeat([]byte{1}, 800)), PaceConfig{
+		SampleRate:    8000,
+		SampleSize:    1,
+		Silence:       0xff,
+		FrameDuration: 10 * time.Millisecond,
+		PreRoll:       30 * time.Millisecond,
+		JitterBuffer:  50 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	defer func() { _ = p.Close() }()
+
+	start := time.Now()
+	data, err := ioutil.ReadAll(p)
+
+	assert.NoError(t, err)
+	assert.Equal(t, bytes.Repeat([]byte{1}, 800), data)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(90*time.Millisecond))
+	assert.Equal(t, 10, p.Stats().Frames)
+	assert.Zero(t, p.Stats().SilentFrames)
+}
+
+func TestPacedReader_Underrun(t *testing.T) {
+	var (
+		pr, pw = io.Pipe()
+		gate   = make(chan struct{})
+	)
+
+	// the last frame is held back until the silence is released in its place
+	go func() {
+		_, _ = pw.Write(bytes.Repeat([]byte{1}, 320))
+		<-gate
+		_, _ = pw.Write(bytes.Repeat([]byte{2}, 160))
+		_ = pw.Close()
+	}()
+
+	p, err := NewPacedReader(pr, PaceConfig{
+		SampleRate:    8000,
+		SampleSize:    2,
+		FrameDuration: 10 * time.Millisecond,
+		PreRoll:       20 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	defer func() { _ = p.Close() }()
+
+	head := make([]byte, 480)
+
+	for i := 0; i < 3; i++ {
+		_, err = io.ReadFull(p, head[i*160:(i+1)*160])
+		assert.NoError(t, err)
+	}
+
+	close(gate)
+
+	tail, err := ioutil.ReadAll(p)
+	assert.NoError(t, err)
+
+	var (
+		data    = append(head, tail...)
+		stats   = p.Stats()
+		silence = stats.SilentFrames * 160
+	)
+
+	assert.Equal(t, 1, stats.Underruns)
+	assert.Greater(t, stats.SilentFrames, 0)
+	assert.Equal(t, stats.Frames, stats.SilentFrames+3)
+	assert.Equal(t, bytes.Repeat([]byte{1}, 320), data[:320])
+	assert.Equal(t, make([]byte, silence), data[320:320+silence])
+	assert.Equal(t, bytes.Repeat([]byte{2}, 160), data[320+silence:])
+}
+
+func TestPacedReader_LateFrames(t *testing.T) {
+	p, err := NewPacedReader(bytes.NewReader(make([]byte, 480)), PaceConfig{
+		SampleRate:    8000,
+		SampleSize:    2,
+		FrameDuration: 10 * time.Millisecond,
+		PreRoll:       30 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	defer func() { _ = p.Close() }()
+
+	buf := make([]byte, 160)
+
+	_, err = io.ReadFull(p, buf)
+	assert.NoError(t, err)
+
+	time.Sleep(40 * time.Millisecond)
+
+	_, err = io.ReadFull(p, buf)
+	assert.NoError(t, err)
+
+	stats := p.Stats()
+
+	assert.Equal(t, 1, stats.LateFrames)
+	assert.GreaterOrEqual(t, int64(stats.MaxLateness), int64(20*time.Millisecond))
+	assert.Zero(t, stats.Underruns)
+}
+
+func TestPacedReader_Close(t *testing.T) {
+	pr, _ := io.Pipe()
+
+	p, err := NewPacedReader(pr, PaceConfig{SampleRate: 8000, SampleSize: 1, PreRoll: time.Second})
+	assert.NoError(t, err)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = p.Close()
+	}()
+
+	_, err = p.Read(make([]byte, 160))
+	assert.ErrorIs(t, err, ErrReaderClosed)
+}
+
+func TestNewPacedReader(t *testing.T) {
+	for _, config := range []PaceConfig{
+		{SampleSize: 2},
+		{SampleRate: 8000, SampleSize: 3},
+		{SampleRate: 8000, SampleSize: 2, PreRoll: -time.Second},
+		{SampleRate: 8000, SampleSize: 2, FrameDuration: time.Microsecond},
+	} {
+		_, err := NewPacedReader(bytes.NewReader(nil), config)
+		assert.ErrorIs(t, err, ErrInvalidPaceConfig)
+	}
+}
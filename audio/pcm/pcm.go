@@ -0,0 +1,124 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package pcm works on the streams of the signed 16-bit little-endian mono lpcm
+package pcm
+
+import (
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+// SampleSize is the size of a single sample in bytes
+const SampleSize = 2
+
+type (
+	zeroReader struct{}
+
+	// alignedReader pads the stream to the whole number of samples
+	alignedReader struct {
+		r      io.Reader
+		odd    bool
+		padded bool
+	}
+)
+
+// Duration returns the duration of the lpcm of the given size
+func Duration(size int64, sampleRate int) time.Duration {
+	if sampleRate <= 0 {
+		return 0
+	}
+
+	return time.Duration(size / SampleSize * int64(time.Second) / int64(sampleRate))
+}
+
+// Size returns the size of the lpcm of the given duration rounded down to the whole sample
+func Size(d time.Duration, sampleRate int) int64 {
+	if sampleRate <= 0 || d <= 0 {
+		return 0
+	}
+
+	return int64(d) * int64(sampleRate) / int64(time.Second) * SampleSize
+}
+
+// Measure reads r to the end and returns the duration of the read lpcm
+func Measure(r io.Reader, sampleRate int) (time.Duration, error) {
+	size, err := io.Copy(ioutil.Discard, r)
+
+	return Duration(size, sampleRate), err
+}
+
+// Silence returns the stream of the silence of the given duration
+func Silence(d time.Duration, sampleRate int) io.Reader {
+	return io.LimitReader(zeroReader{}, Size(d, sampleRate))
+}
+
+// Concat joins the clips into a single stream separated by the silence of the gap duration,
+// the clips of the odd size are padded to the whole sample
+func Concat(sampleRate int, gap time.Duration, clips ...io.Reader) io.Reader {
+	readers := make([]io.Reader, 0, len(clips)*2)
+
+	for i, clip := range clips {
+		if i > 0 && gap > 0 {
+			readers = append(readers, Silence(gap, sampleRate))
+		}
+
+		readers = append(readers, &alignedReader{r: clip})
+	}
+
+	return io.MultiReader(readers...)
+}
+
+// InsertSilence inserts the silence of the given duration at the position of the stream
+func InsertSilence(r io.Reader, sampleRate int, at, d time.Duration) io.Reader {
+	return io.MultiReader(io.LimitReader(r, Size(at, sampleRate)), Silence(d, sampleRate), r)
+}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+
+	return len(p), nil
+}
+
+func (a *alignedReader) Read(p []byte) (int, error) {
+	if a.padded {
+		return 0, io.EOF
+	}
+
+	n, err := a.r.Read(p)
+
+	if n%2 != 0 {
+		a.odd = !a.odd
+	}
+
+	if err == io.EOF && a.odd && n < len(p) {
+		p[n] = 0
+		n++
+		a.padded = true
+	} else if err == io.EOF && a.odd {
+		return n, nil
+	}
+
+	return n, err
+}
@@ -0,0 +1,108 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package pcm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math"
+	"testing"
+	"time"
+)
+
+// tone returns the sine of the given frequency and amplitude in the full scale fraction
+func tone(d time.Duration, sampleRate int, frequency, amplitude float64) []byte {
+	data := make([]byte, Size(d, sampleRate))
+
+	for i := 0; i < len(data)/SampleSize; i++ {
+		value := amplitude * fullScale * math.Sin(2*math.Pi*frequency*float64(i)/float64(sampleRate))
+		binary.LittleEndian.PutUint16(data[i*SampleSize:], uint16(int16(math.Max(math.Min(value, 32767), -32768))))
+	}
+
+	return data
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		size       int64
+		sampleRate int
+		expected   time.Duration
+	}{
+		{size: 16000, sampleRate: 8000, expected: time.Second},
+		{size: 32000, sampleRate: 16000, expected: time.Second},
+		{size: 960, sampleRate: 48000, expected: 10 * time.Millisecond},
+		{size: 3, sampleRate: 8000, expected: 125 * time.Microsecond},
+		{size: 16000, sampleRate: 0, expected: 0},
+	}
+
+	for _, entry := range tests {
+		assert.Equal(t, entry.expected, Duration(entry.size, entry.sampleRate))
+	}
+}
+
+func TestSize(t *testing.T) {
+	assert.Equal(t, int64(16000), Size(time.Second, 8000))
+	assert.Equal(t, int64(320), Size(10*time.Millisecond, 16000))
+	assert.Equal(t, int64(0), Size(-time.Second, 8000))
+	assert.Equal(t, int64(0), Size(time.Second, 0))
+	assert.Equal(t, int64(0), Size(100*time.Microsecond, 8000))
+}
+
+func TestMeasure(t *testing.T) {
+	d, err := Measure(bytes.NewReader(make([]byte, 96000)), 48000)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, d)
+}
+
+func TestSilence(t *testing.T) {
+	data, err := ioutil.ReadAll(Silence(250*time.Millisecond, 16000))
+
+	assert.NoError(t, err)
+	assert.Equal(t, make([]byte, 8000), data)
+}
+
+func TestConcat(t *testing.T) {
+	data, err := ioutil.ReadAll(Concat(
+		8000, time.Millisecond,
+		bytes.NewReader([]byte{1, 2, 3}),
+		bytes.NewReader([]byte{4, 5}),
+		bytes.NewReader(nil),
+	))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{
+		1, 2, 3, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		4, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	}, data)
+}
+
+func TestInsertSilence(t *testing.T) {
+	data, err := ioutil.ReadAll(InsertSilence(bytes.NewReader([]byte{1, 2, 3, 4}), 1000, time.Millisecond, time.Millisecond))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 0, 0, 3, 4}, data)
+}
@@ -0,0 +1,258 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package pcm
+
+import (
+	"encoding/binary"
+	"github.com/lEx0/yatts/audio"
+	"io"
+	"math"
+)
+
+const (
+	// resampleZeros is the number of the sinc zero crossings on each side of the filter
+	resampleZeros = 16
+	// resampleCutoff is the passband edge relative to the lower nyquist frequency,
+	// the transition band above it keeps the aliasing out of the passband
+	resampleCutoff = 0.95
+	resampleChunk  = 4096
+	// resampleMaxPhases caps the filter table of the coprime rates,
+	// the taps between the phases are interpolated linearly
+	resampleMaxPhases = 512
+)
+
+// resampler converts the sample rate with the polyphase windowed-sinc filter
+type resampler struct {
+	r      io.Reader
+	up     int64
+	down   int64
+	half   int64
+	phases [][]float64
+	// interpolated holds the taps interpolated between the phases
+	interpolated []float64
+	// history holds the input samples starting at the absolute index base
+	history []float64
+	base    int64
+	count   int64
+	next    int64
+	raw     []byte
+	carry   []byte
+	out     []byte
+	eof     bool
+	err     error
+}
+
+// NewResampler returns the stream of the lpcm read from r converted from one sample rate to another,
+// the windowed-sinc low-pass filter suppresses the aliasing when the rate is lowered
+func NewResampler(r io.Reader, from, to int) (io.Reader, error) {
+	if from <= 0 || to <= 0 {
+		return nil, audio.ErrInvalidSampleRate
+	} else if from == to {
+		return r, nil
+	}
+
+	g := gcd(from, to)
+	up, down := int64(to/g), int64(from/g)
+	cutoff := resampleCutoff * math.Min(1, float64(up)/float64(down))
+	half := int64(math.Ceil(resampleZeros / cutoff))
+	phases := up
+
+	if phases > resampleMaxPhases {
+		phases = resampleMaxPhases
+	}
+
+	return &resampler{
+		r:       r,
+		up:      up,
+		down:    down,
+		half:    half,
+		phases:  resampleFilter(phases, up, half, cutoff),
+		history: make([]float64, half),
+		base:    -half,
+		raw:     make([]byte, resampleChunk),
+	}, nil
+}
+
+// resampleFilter returns the Blackman windowed-sinc filter split into the phases,
+// the taps of every phase are normalized to the unity gain, the table of fewer phases than up
+// has the extra phase of the next sample to interpolate the last one with
+func resampleFilter(phases, up, half int64, cutoff float64) [][]float64 {
+	count := phases
+
+	if phases < up {
+		count++
+	}
+
+	result := make([][]float64, count)
+
+	for p := range result {
+		var (
+			taps = make([]float64, 2*half)
+			sum  float64
+		)
+
+		for k := range taps {
+			// the distance between the output position and the input sample
+			x := float64(p)/float64(phases) + float64(half-1-int64(k))
+			w := 0.42 + 0.5*math.Cos(math.Pi*x/float64(half)) + 0.08*math.Cos(2*math.Pi*x/float64(half))
+
+			if math.Abs(x) >= float64(half) {
+				w = 0
+			}
+
+			taps[k] = cutoff * sinc(cutoff*x) * w
+			sum += taps[k]
+		}
+
+		for k := range taps {
+			taps[k] /= sum
+		}
+
+		result[p] = taps
+	}
+
+	return result
+}
+
+func (s *resampler) Read(p []byte) (int, error) {
+	for len(s.out) == 0 && s.err == nil {
+		s.fill()
+		s.produce()
+	}
+
+	if len(s.out) == 0 {
+		return 0, s.err
+	}
+
+	n := copy(p, s.out)
+	s.out = s.out[n:]
+
+	return n, nil
+}
+
+// fill reads the next chunk of the input samples, the end of the stream is padded with zeros
+func (s *resampler) fill() {
+	n, err := s.r.Read(s.raw)
+	data := append(s.carry, s.raw[:n]...)
+	count := len(data) / SampleSize
+
+	for i := 0; i < count; i++ {
+		s.history = append(s.history, float64(int16(binary.LittleEndian.Uint16(data[i*SampleSize:]))))
+	}
+
+	s.count += int64(count)
+	s.carry = append(s.carry[:0], data[count*SampleSize:]...)
+
+	if err == io.EOF {
+		s.eof = true
+		s.history = append(s.history, make([]float64, s.half)...)
+	} else if err != nil {
+		s.err = err
+	}
+}
+
+// produce filters every output sample the history has enough input for
+func (s *resampler) produce() {
+	available := s.base + int64(len(s.history))
+
+	for {
+		position := s.next * s.down
+		index := position / s.up
+
+		if s.eof && position >= s.count*s.up {
+			s.err = io.EOF
+
+			break
+		} else if index+s.half >= available {
+			break
+		}
+
+		var (
+			taps   = s.taps(position % s.up)
+			window = s.history[index-s.half+1-s.base:]
+			value  float64
+		)
+
+		for k, tap := range taps {
+			value += tap * window[k]
+		}
+
+		s.out = append(s.out, 0, 0)
+		binary.LittleEndian.PutUint16(s.out[len(s.out)-SampleSize:], uint16(clip(value)))
+		s.next++
+	}
+
+	// the samples before the window of the next output are not needed anymore
+	if drop := s.next*s.down/s.up - s.half + 1 - s.base; drop > 0 {
+		s.history = append(s.history[:0], s.history[drop:]...)
+		s.base += drop
+	}
+}
+
+// taps returns the filter of the phase of up, the phases between the ones of the table are interpolated
+func (s *resampler) taps(phase int64) []float64 {
+	if int64(len(s.phases)) == s.up {
+		return s.phases[phase]
+	}
+
+	scaled := phase * int64(len(s.phases)-1)
+
+	if scaled%s.up == 0 {
+		return s.phases[scaled/s.up]
+	}
+
+	var (
+		lower  = s.phases[scaled/s.up]
+		upper  = s.phases[scaled/s.up+1]
+		weight = float64(scaled%s.up) / float64(s.up)
+	)
+
+	if s.interpolated == nil {
+		s.interpolated = make([]float64, len(lower))
+	}
+
+	for k := range lower {
+		s.interpolated[k] = lower[k] + weight*(upper[k]-lower[k])
+	}
+
+	return s.interpolated
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+
+	return math.Sin(math.Pi*x) / (math.Pi * x)
+}
+
+func clip(value float64) int16 {
+	return int16(math.Max(math.MinInt16, math.Min(math.MaxInt16, math.Round(value))))
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
@@ -0,0 +1,136 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package pcm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/lEx0/yatts/audio"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"math"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+func resample(t *testing.T, r io.Reader, from, to int) []byte {
+	resampled, err := NewResampler(r, from, to)
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(resampled)
+	assert.NoError(t, err)
+
+	return data
+}
+
+func TestNewResampler(t *testing.T) {
+	tests := []struct {
+		from int
+		to   int
+	}{
+		{from: 48000, to: 8000},
+		{from: 48000, to: 16000},
+		{from: 48000, to: 22050},
+		{from: 22050, to: 48000},
+		{from: 8000, to: 48000},
+		{from: 16000, to: 22050},
+		{from: 22050, to: 8000},
+		// the coprime rates interpolate the taps between the capped phases
+		{from: 48000, to: 44101},
+		{from: 44101, to: 48000},
+	}
+
+	for _, entry := range tests {
+		t.Run(fmt.Sprintf("%d to %d", entry.from, entry.to), func(t *testing.T) {
+			var (
+				input    = tone(time.Second, entry.from, 440, 0.5)
+				data     = resample(t, bytes.NewReader(input), entry.from, entry.to)
+				expected = tone(time.Second, entry.to, 440, 0.5)
+				margin   = entry.to / 20
+			)
+
+			assert.Len(t, data, len(expected))
+
+			// the edges are distorted by the filter running into the zero padding
+			var maxError float64
+
+			for i := margin; i < len(expected)/SampleSize-margin; i++ {
+				got := float64(int16(binary.LittleEndian.Uint16(data[i*SampleSize:])))
+				want := float64(int16(binary.LittleEndian.Uint16(expected[i*SampleSize:])))
+				maxError = math.Max(maxError, math.Abs(got-want))
+			}
+
+			assert.Less(t, maxError, 0.005*fullScale)
+		})
+	}
+}
+
+func TestNewResampler_Phases(t *testing.T) {
+	for _, entry := range []struct {
+		from   int
+		to     int
+		phases int
+	}{
+		{from: 44100, to: 48000, phases: 160},
+		{from: 48000, to: 44101, phases: resampleMaxPhases + 1},
+		{from: 7919, to: 48611, phases: resampleMaxPhases + 1},
+	} {
+		r, err := NewResampler(bytes.NewReader(nil), entry.from, entry.to)
+		assert.NoError(t, err)
+		assert.Len(t, r.(*resampler).phases, entry.phases)
+	}
+}
+
+func TestNewResampler_AntiAliasing(t *testing.T) {
+	// 6 kHz is above the nyquist frequency of 8 kHz and would fold back to 2 kHz
+	data := resample(t, bytes.NewReader(tone(time.Second, 48000, 6000, 0.9)), 48000, 8000)
+
+	assert.Less(t, Level(data[1600:len(data)-1600]), -60.0)
+}
+
+func TestNewResampler_Streaming(t *testing.T) {
+	input := append(tone(100*time.Millisecond, 16000, 1000, 0.5), 7)
+	expected := resample(t, bytes.NewReader(input), 16000, 22050)
+	data := resample(t, iotest.OneByteReader(bytes.NewReader(input)), 16000, 22050)
+
+	assert.Equal(t, expected, data)
+}
+
+func TestNewResampler_Errors(t *testing.T) {
+	_, err := NewResampler(bytes.NewReader(nil), 0, 8000)
+	assert.ErrorIs(t, err, audio.ErrInvalidSampleRate)
+
+	r := bytes.NewReader(nil)
+	same, err := NewResampler(r, 8000, 8000)
+	assert.NoError(t, err)
+	assert.Equal(t, r, same)
+
+	failing, err := NewResampler(iotest.TimeoutReader(bytes.NewReader(make([]byte, 8000))), 48000, 8000)
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(failing)
+	assert.ErrorIs(t, err, iotest.ErrTimeout)
+	assert.NotEmpty(t, data)
+}
@@ -0,0 +1,157 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package pcm
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultSilenceThreshold is the level in dBFS below which the window is silent
+	DefaultSilenceThreshold = -40
+	// DefaultSilenceWindow is the length of the window the level is measured on
+	DefaultSilenceWindow = 10 * time.Millisecond
+
+	fullScale = 32768
+)
+
+type (
+	// SilenceDetector detects the silence by the energy of the fixed windows of the samples
+	SilenceDetector struct {
+		// Threshold is the RMS level in dBFS below which the window is silent,
+		// DefaultSilenceThreshold is used when zero
+		Threshold float64
+		// Window is the length of the window, DefaultSilenceWindow is used when zero
+		Window time.Duration
+	}
+
+	// trimReader drops the leading silent windows and holds the silent windows back
+	// until the next loud one, so the trailing silence is never returned
+	trimReader struct {
+		r        io.Reader
+		detector SilenceDetector
+		window   []byte
+		pending  []byte
+		out      []byte
+		started  bool
+		err      error
+	}
+)
+
+// Level returns the RMS level of the samples in dBFS, the level of the empty
+// or zero samples is minus infinity
+func Level(samples []byte) float64 {
+	var (
+		sum   float64
+		count = len(samples) / SampleSize
+	)
+
+	if count == 0 {
+		return math.Inf(-1)
+	}
+
+	for i := 0; i < count; i++ {
+		sample := float64(int16(binary.LittleEndian.Uint16(samples[i*SampleSize:])))
+		sum += sample * sample
+	}
+
+	return 20 * math.Log10(math.Sqrt(sum/float64(count))/fullScale)
+}
+
+// IsSilent reports whether the level of the samples is below the threshold
+func (d SilenceDetector) IsSilent(samples []byte) bool {
+	return Level(samples) < d.threshold()
+}
+
+// Trim returns the stream with the leading and the trailing silence removed,
+// the silence is detected with the window precision
+func (d SilenceDetector) Trim(r io.Reader, sampleRate int) io.Reader {
+	return &trimReader{r: r, detector: d, window: make([]byte, d.windowSize(sampleRate))}
+}
+
+// TrimSilence removes the leading and the trailing silence with the default detector
+func TrimSilence(r io.Reader, sampleRate int) io.Reader {
+	return SilenceDetector{}.Trim(r, sampleRate)
+}
+
+func (d SilenceDetector) threshold() float64 {
+	if d.Threshold == 0 {
+		return DefaultSilenceThreshold
+	}
+
+	return d.Threshold
+}
+
+func (d SilenceDetector) windowSize(sampleRate int) int64 {
+	window := d.Window
+
+	if window <= 0 {
+		window = DefaultSilenceWindow
+	}
+
+	if size := Size(window, sampleRate); size > 0 {
+		return size
+	}
+
+	return SampleSize
+}
+
+func (t *trimReader) Read(p []byte) (int, error) {
+	for len(t.out) == 0 && t.err == nil {
+		t.next()
+	}
+
+	if len(t.out) == 0 {
+		return 0, t.err
+	}
+
+	n := copy(p, t.out)
+	t.out = t.out[n:]
+
+	return n, nil
+}
+
+// next reads a single window and moves it to the output when the audio is heard
+func (t *trimReader) next() {
+	n, err := io.ReadFull(t.r, t.window)
+
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+
+	if n > 0 {
+		window := t.window[:n]
+
+		if !t.detector.IsSilent(window) {
+			t.started = true
+			t.out = append(append(t.out, t.pending...), window...)
+			t.pending = t.pending[:0]
+		} else if t.started {
+			t.pending = append(t.pending, window...)
+		}
+	}
+
+	t.err = err
+}
@@ -0,0 +1,109 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package pcm
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLevel(t *testing.T) {
+	assert.True(t, math.IsInf(Level(nil), -1))
+	assert.True(t, math.IsInf(Level(make([]byte, 100)), -1))
+	// the RMS of the sine is the amplitude divided by the square root of two
+	assert.InDelta(t, -3.01, Level(tone(100*time.Millisecond, 8000, 400, 1)), 0.05)
+	assert.InDelta(t, -23.01, Level(tone(100*time.Millisecond, 8000, 400, 0.1)), 0.05)
+}
+
+func TestSilenceDetector_IsSilent(t *testing.T) {
+	tests := []struct {
+		name     string
+		detector SilenceDetector
+		samples  []byte
+		expected bool
+	}{
+		{name: "zeros", samples: make([]byte, 160), expected: true},
+		{name: "quiet noise", samples: tone(10*time.Millisecond, 8000, 400, 0.001), expected: true},
+		{name: "speech level", samples: tone(10*time.Millisecond, 8000, 400, 0.3), expected: false},
+		{
+			name:     "custom threshold",
+			detector: SilenceDetector{Threshold: -10},
+			samples:  tone(10*time.Millisecond, 8000, 400, 0.3),
+			expected: true,
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			assert.Equal(t, entry.expected, entry.detector.IsSilent(entry.samples))
+		})
+	}
+}
+
+func TestSilenceDetector_Trim(t *testing.T) {
+	const sampleRate = 16000
+
+	var (
+		speech = tone(100*time.Millisecond, sampleRate, 440, 0.5)
+		pause  = make([]byte, Size(50*time.Millisecond, sampleRate))
+		noise  = tone(30*time.Millisecond, sampleRate, 440, 0.001)
+	)
+
+	tests := []struct {
+		name     string
+		input    [][]byte
+		expected [][]byte
+	}{
+		{
+			name:     "leading and trailing silence",
+			input:    [][]byte{pause, noise, speech, pause},
+			expected: [][]byte{speech},
+		},
+		{
+			name:     "inner pause is kept",
+			input:    [][]byte{pause, speech, pause, speech, noise},
+			expected: [][]byte{speech, pause, speech},
+		},
+		{
+			name:     "no silence",
+			input:    [][]byte{speech},
+			expected: [][]byte{speech},
+		},
+		{
+			name:  "only silence",
+			input: [][]byte{pause, noise},
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			data, err := ioutil.ReadAll(TrimSilence(bytes.NewReader(bytes.Join(entry.input, nil)), sampleRate))
+
+			assert.NoError(t, err)
+			assert.Equal(t, bytes.Join(entry.expected, nil), data)
+		})
+	}
+}
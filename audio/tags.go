@@ -0,0 +1,64 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audio
+
+import "time"
+
+type (
+	// Tags is the metadata written into the saved audio files
+	Tags struct {
+		Title  string
+		Artist string
+		Album  string
+		// Track and TrackTotal are skipped when zero
+		Track      int
+		TrackTotal int
+		Chapters   []Chapter
+	}
+
+	// Chapter is the titled part of the audio
+	Chapter struct {
+		Title string
+		Start time.Duration
+		// End is the start of the next chapter or the end of the audio when zero
+		End time.Duration
+	}
+)
+
+// ChapterEnds returns the chapters with the zero ends replaced by the start of the next chapter,
+// the end of the last chapter is replaced by the duration
+func (t Tags) ChapterEnds(duration time.Duration) []Chapter {
+	result := make([]Chapter, len(t.Chapters))
+	copy(result, t.Chapters)
+
+	for i := range result {
+		if result[i].End != 0 {
+			continue
+		} else if i+1 < len(result) {
+			result[i].End = result[i+1].Start
+		} else {
+			result[i].End = duration
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,254 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package audio contains the containers and the codecs of the synthesized audio
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrInvalidSampleRate = errors.New("invalid sample rate")
+	ErrClosed            = errors.New("writer is closed")
+)
+
+const (
+	// WAVHeaderSize is the size of the canonical RIFF header of the pcm written before the samples,
+	// the header of the other formats is WAVFormat.HeaderSize long
+	WAVHeaderSize = 44
+	// StreamingSize is written as the chunk sizes when the total size is unknown
+	StreamingSize = 0xffffffff
+
+	wavFormatPCM     = 1
+	pcmBitsPerSample = 16
+	wavSizeOffset    = 4
+	// the non-pcm formats have the fmt chunk with the cbSize field and the fact chunk with the number of samples
+	wavFactHeaderSize = 58
+	wavFactOffset     = 46
+)
+
+type (
+	// WAVFormat describes the samples wrapped into the wav container
+	WAVFormat struct {
+		// Tag is the wav format code, 1 for pcm
+		Tag           uint16
+		SampleRate    int
+		Channels      int
+		BitsPerSample int
+	}
+
+	// WAVWriter wraps the written samples into the wav container, the sizes are patched
+	// on Close when the writer is seekable and set to StreamingSize otherwise
+	WAVWriter struct {
+		format  WAVFormat
+		w       io.Writer
+		seeker  io.Seeker
+		start   int64
+		written int64
+		closed  bool
+	}
+)
+
+// PCMFormat returns the format of the 16-bit mono lpcm returned by the API
+func PCMFormat(sampleRate int) WAVFormat {
+	return WAVFormat{Tag: wavFormatPCM, SampleRate: sampleRate, Channels: 1, BitsPerSample: pcmBitsPerSample}
+}
+
+// Duration returns the duration of the samples of the given size
+func (f WAVFormat) Duration(size int64) time.Duration {
+	bytesPerSecond := int64(f.SampleRate * f.Channels * f.BitsPerSample / 8)
+
+	if bytesPerSecond <= 0 {
+		return 0
+	}
+
+	return time.Duration(size * int64(time.Second) / bytesPerSecond)
+}
+
+// HeaderSize returns the size of the header written before the samples
+func (f WAVFormat) HeaderSize() int {
+	if f.Tag == wavFormatPCM {
+		return WAVHeaderSize
+	}
+
+	return wavFactHeaderSize
+}
+
+// Header returns the wav header for the data of the given size
+func (f WAVFormat) Header(dataSize uint32) []byte {
+	var (
+		size      = f.HeaderSize()
+		header    = make([]byte, size)
+		blockSize = f.Channels * f.BitsPerSample / 8
+		riffSize  = uint32(StreamingSize)
+		fmtSize   = 16
+	)
+
+	if dataSize != StreamingSize {
+		riffSize = dataSize + dataSize%2 + uint32(size) - 8
+	}
+
+	if f.Tag != wavFormatPCM {
+		// the cbSize of the extension is zero
+		fmtSize = 18
+	}
+
+	copy(header, "RIFF")
+	binary.LittleEndian.PutUint32(header[wavSizeOffset:], riffSize)
+	copy(header[8:], "WAVEfmt ")
+	binary.LittleEndian.PutUint32(header[16:], uint32(fmtSize))
+	binary.LittleEndian.PutUint16(header[20:], f.Tag)
+	binary.LittleEndian.PutUint16(header[22:], uint16(f.Channels))
+	binary.LittleEndian.PutUint32(header[24:], uint32(f.SampleRate))
+	binary.LittleEndian.PutUint32(header[28:], uint32(f.SampleRate*blockSize))
+	binary.LittleEndian.PutUint16(header[32:], uint16(blockSize))
+	binary.LittleEndian.PutUint16(header[34:], uint16(f.BitsPerSample))
+
+	if f.Tag != wavFormatPCM {
+		copy(header[20+fmtSize:], "fact")
+		binary.LittleEndian.PutUint32(header[24+fmtSize:], 4)
+		binary.LittleEndian.PutUint32(header[wavFactOffset:], f.samples(dataSize))
+	}
+
+	copy(header[size-8:], "data")
+	binary.LittleEndian.PutUint32(header[size-4:], dataSize)
+
+	return header
+}
+
+// samples returns the number of the samples per channel in the data of the given size
+func (f WAVFormat) samples(dataSize uint32) uint32 {
+	blockSize := uint32(f.Channels * f.BitsPerSample / 8)
+
+	if dataSize == StreamingSize || blockSize == 0 {
+		return StreamingSize
+	}
+
+	return dataSize / blockSize
+}
+
+// NewWAVWriter writes the header of the 16-bit mono lpcm to w
+func NewWAVWriter(w io.Writer, sampleRate int) (*WAVWriter, error) {
+	return NewWAVFormatWriter(w, PCMFormat(sampleRate))
+}
+
+// NewWAVFormatWriter writes the header of the format to w
+func NewWAVFormatWriter(w io.Writer, format WAVFormat) (*WAVWriter, error) {
+	if format.SampleRate <= 0 {
+		return nil, ErrInvalidSampleRate
+	}
+
+	result := &WAVWriter{format: format, w: w}
+
+	// the files opened on the pipes are seekers that fail to seek
+	if seeker, ok := w.(io.Seeker); ok {
+		if start, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			result.seeker, result.start = seeker, start
+		}
+	}
+
+	if _, err := w.Write(format.Header(StreamingSize)); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Write writes the samples
+func (w *WAVWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, ErrClosed
+	}
+
+	n, err := w.w.Write(p)
+	w.written += int64(n)
+
+	return n, err
+}
+
+// Close pads the data to the even size and patches the sizes of the seekable writer,
+// the underlying writer is not closed
+func (w *WAVWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+
+	w.closed = true
+
+	if w.written%2 != 0 {
+		if _, err := w.w.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+
+	headerSize := int64(w.format.HeaderSize())
+
+	if w.seeker == nil || w.written+headerSize-8 >= StreamingSize {
+		return nil
+	}
+
+	end, err := w.seeker.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return err
+	}
+
+	var (
+		size    = make([]byte, 4)
+		patches = map[int64]int64{
+			wavSizeOffset:  w.written + w.written%2 + headerSize - 8,
+			headerSize - 4: w.written,
+		}
+	)
+
+	if w.format.Tag != wavFormatPCM {
+		patches[wavFactOffset] = int64(w.format.samples(uint32(w.written)))
+	}
+
+	for offset, value := range patches {
+		binary.LittleEndian.PutUint32(size, uint32(value))
+
+		if _, err = w.seeker.Seek(w.start+offset, io.SeekStart); err != nil {
+			return err
+		} else if _, err = w.w.Write(size); err != nil {
+			return err
+		}
+	}
+
+	_, err = w.seeker.Seek(end, io.SeekStart)
+
+	return err
+}
+
+// NewWAVReader returns the wav stream of the 16-bit mono lpcm read from r with the streaming sizes
+func NewWAVReader(r io.Reader, sampleRate int) io.Reader {
+	return NewWAVFormatReader(r, PCMFormat(sampleRate))
+}
+
+// NewWAVFormatReader returns the wav stream of the samples of the format read from r with the streaming sizes
+func NewWAVFormatReader(r io.Reader, format WAVFormat) io.Reader {
+	return io.MultiReader(bytes.NewReader(format.Header(StreamingSize)), r)
+}
@@ -0,0 +1,143 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWAVFormat_Header(t *testing.T) {
+	header := PCMFormat(8000).Header(1600)
+
+	assert.Len(t, header, WAVHeaderSize)
+	assert.Equal(t, "RIFF", string(header[:4]))
+	assert.Equal(t, uint32(1600+36), binary.LittleEndian.Uint32(header[4:]))
+	assert.Equal(t, "WAVEfmt ", string(header[8:16]))
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(header[20:]))
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(header[22:]))
+	assert.Equal(t, uint32(8000), binary.LittleEndian.Uint32(header[24:]))
+	assert.Equal(t, uint32(16000), binary.LittleEndian.Uint32(header[28:]))
+	assert.Equal(t, uint16(2), binary.LittleEndian.Uint16(header[32:]))
+	assert.Equal(t, uint16(16), binary.LittleEndian.Uint16(header[34:]))
+	assert.Equal(t, "data", string(header[36:40]))
+	assert.Equal(t, uint32(1600), binary.LittleEndian.Uint32(header[40:]))
+}
+
+func TestWAVFormat_Duration(t *testing.T) {
+	assert.Equal(t, 100*time.Millisecond, PCMFormat(8000).Duration(1600))
+	assert.Equal(t, 100*time.Millisecond, G711ULaw.WAVFormat().Duration(800))
+	assert.Equal(t, time.Duration(0), WAVFormat{}.Duration(800))
+}
+
+func TestWAVFormat_HeaderG711(t *testing.T) {
+	format := G711ALaw.WAVFormat()
+	header := format.Header(801)
+
+	assert.Len(t, header, format.HeaderSize())
+	assert.Equal(t, uint32(801+1+58-8), binary.LittleEndian.Uint32(header[4:]))
+	assert.Equal(t, uint32(18), binary.LittleEndian.Uint32(header[16:]))
+	assert.Equal(t, uint16(0), binary.LittleEndian.Uint16(header[36:]))
+	assert.Equal(t, "fact", string(header[38:42]))
+	assert.Equal(t, uint32(4), binary.LittleEndian.Uint32(header[42:]))
+	assert.Equal(t, uint32(801), binary.LittleEndian.Uint32(header[46:]))
+	assert.Equal(t, "data", string(header[50:54]))
+	assert.Equal(t, uint32(801), binary.LittleEndian.Uint32(header[54:]))
+	assert.Equal(t, uint32(StreamingSize), binary.LittleEndian.Uint32(format.Header(StreamingSize)[46:]))
+}
+
+func TestWAVWriter(t *testing.T) {
+	t.Run("seekable writer gets the sizes patched", func(t *testing.T) {
+		f, err := ioutil.TempFile("", "yatts-*.wav")
+		assert.NoError(t, err)
+
+		defer func() { _ = os.Remove(f.Name()) }()
+		defer func() { _ = f.Close() }()
+
+		w, err := NewWAVWriter(f, 16000)
+		assert.NoError(t, err)
+
+		_, err = w.Write(make([]byte, 1000))
+		assert.NoError(t, err)
+		_, err = w.Write(make([]byte, 600))
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+
+		_, err = w.Write([]byte{1})
+		assert.ErrorIs(t, err, ErrClosed)
+
+		data, err := ioutil.ReadFile(f.Name())
+		assert.NoError(t, err)
+		assert.Len(t, data, WAVHeaderSize+1600)
+		assert.Equal(t, PCMFormat(16000).Header(1600), data[:WAVHeaderSize])
+	})
+	t.Run("seekable writer gets the G.711 sizes patched", func(t *testing.T) {
+		f, err := ioutil.TempFile("", "yatts-*.wav")
+		assert.NoError(t, err)
+
+		defer func() { _ = os.Remove(f.Name()) }()
+		defer func() { _ = f.Close() }()
+
+		format := G711ULaw.WAVFormat()
+		w, err := NewWAVFormatWriter(f, format)
+		assert.NoError(t, err)
+
+		_, err = w.Write(make([]byte, 801))
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+
+		data, err := ioutil.ReadFile(f.Name())
+		assert.NoError(t, err)
+		assert.Len(t, data, format.HeaderSize()+802)
+		assert.Equal(t, format.Header(801), data[:format.HeaderSize()])
+	})
+	t.Run("non seekable writer gets the streaming sizes", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		w, err := NewWAVWriter(&buf, 48000)
+		assert.NoError(t, err)
+
+		_, err = w.Write(make([]byte, 3))
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+
+		assert.Len(t, buf.Bytes(), WAVHeaderSize+4)
+		assert.Equal(t, uint32(StreamingSize), binary.LittleEndian.Uint32(buf.Bytes()[4:]))
+		assert.Equal(t, uint32(StreamingSize), binary.LittleEndian.Uint32(buf.Bytes()[40:]))
+	})
+	t.Run("invalid sample rate", func(t *testing.T) {
+		_, err := NewWAVWriter(&bytes.Buffer{}, 0)
+		assert.ErrorIs(t, err, ErrInvalidSampleRate)
+	})
+}
+
+func TestNewWAVReader(t *testing.T) {
+	data, err := ioutil.ReadAll(NewWAVReader(bytes.NewReader([]byte{1, 2, 3, 4}), 8000))
+
+	assert.NoError(t, err)
+	assert.Equal(t, append(PCMFormat(8000).Header(StreamingSize), 1, 2, 3, 4), data)
+}
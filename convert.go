@@ -0,0 +1,133 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/audio/pcm"
+	"github.com/lEx0/yatts/request"
+	"io"
+)
+
+type (
+	// conversion is the local processing of the lpcm returned by the API
+	conversion struct {
+		// sampleRate is the rate the lpcm is resampled to, zero when it is not resampled
+		sampleRate int
+		// codec is the G.711 codec the lpcm is encoded with, zero when it is not encoded
+		codec audio.G711
+	}
+
+	// readCloser reads the wrapped stream and closes the response body
+	readCloser struct {
+		io.Reader
+		io.Closer
+	}
+)
+
+var g711Formats = map[string]audio.G711{
+	string(request.OutputFormatULaw): audio.G711ULaw,
+	string(request.OutputFormatALaw): audio.G711ALaw,
+}
+
+// convert returns the request sent to the API for the requested one
+// and the local conversion of the response to the requested format
+func convert(r *request.Request) (*request.Request, conversion) {
+	var (
+		sent = *r
+		conv conversion
+	)
+
+	if sent.Resample {
+		conv.resample(&sent)
+	}
+
+	conv.encode(&sent)
+
+	return &sent, conv
+}
+
+// resample switches the lpcm of the rate the API does not offer to the closest higher offered rate
+func (c *conversion) resample(r *request.Request) {
+	if r.OutputFormat != string(request.OutputFormatLPCM) || r.SampleRate <= 0 {
+		return
+	}
+
+	if offered := request.OfferedSampleRate(r.SampleRate); offered != r.SampleRate {
+		c.sampleRate, r.SampleRate = r.SampleRate, offered
+	}
+}
+
+// encode switches the G.711 formats to the 8 kHz lpcm encoded locally
+func (c *conversion) encode(r *request.Request) {
+	if codec, ok := g711Formats[r.OutputFormat]; ok {
+		c.codec = codec
+		r.OutputFormat = string(request.OutputFormatLPCM)
+		r.SampleRate = audio.G711SampleRate
+	}
+}
+
+// format returns the wav format of the converted response of the given sample rate
+func (c conversion) format(sampleRate int) audio.WAVFormat {
+	switch {
+	case c.codec != 0:
+		return c.codec.WAVFormat()
+	case c.sampleRate != 0:
+		return audio.PCMFormat(c.sampleRate)
+	case sampleRate == 0:
+		return audio.PCMFormat(request.DefaultSampleRate)
+	default:
+		return audio.PCMFormat(sampleRate)
+	}
+}
+
+// apply converts the response body of the given sample rate and returns it
+// with the wav format of the converted audio
+func (c conversion) apply(body io.ReadCloser, sampleRate int) (io.ReadCloser, audio.WAVFormat, error) {
+	if sampleRate == 0 {
+		sampleRate = request.DefaultSampleRate
+	}
+
+	if c.sampleRate == 0 && c.codec == 0 {
+		return body, c.format(sampleRate), nil
+	}
+
+	var reader io.Reader = body
+
+	if c.sampleRate != 0 {
+		resampled, err := pcm.NewResampler(body, sampleRate, c.sampleRate)
+
+		if err != nil {
+			_ = body.Close()
+
+			return nil, audio.WAVFormat{}, err
+		}
+
+		reader = resampled
+	}
+
+	if c.codec != 0 {
+		reader = audio.NewG711Encoder(reader, c.codec)
+	}
+
+	return readCloser{Reader: reader, Closer: body}, c.format(sampleRate), nil
+}
@@ -0,0 +1,224 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"bytes"
+	"context"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/audio/oggopus"
+	"github.com/lEx0/yatts/auth"
+	"github.com/lEx0/yatts/request"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestYaTTS_SpeakDialogue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		form, err := url.ParseQuery(string(body))
+		assert.NoError(t, err)
+		assert.Equal(t, "8000", form.Get("sampleRateHertz"))
+
+		// 100ms of the voice for alena and 200ms for filipp
+		size := 1600
+
+		if form.Get("voice") == "filipp" {
+			size = 3200
+		}
+
+		_, _ = w.Write(bytes.Repeat([]byte{1}, size))
+	}))
+	defer server.Close()
+
+	client := NewYaTTS(
+		auth.NewAPITokenAuth("token"),
+		server.Client(),
+		request.OutputFormat(request.OutputFormatLPCM),
+		request.SampleRate(request.OutputSampleRate8k),
+	)
+	client.SetTTSEndpointURL(server.URL)
+
+	stream, err := client.SpeakDialogue(context.Background(), request.DialogueEntity{
+		Turns: []request.DialogueTurn{
+			{Speaker: "alice", Text: "Привет"},
+			{Speaker: "bob", Text: "Здравствуй"},
+			{Speaker: "alice", Text: "Пока"},
+		},
+		Speakers: map[string][]request.Option{
+			"alice": {request.Voice(request.VoiceAlena)},
+			"bob":   {request.Voice(request.VoiceFilipp)},
+		},
+		Gap: 50 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Close())
+
+	assert.Len(t, data, 1600+800+3200+800+1600)
+	assert.Equal(t, make([]byte, 800), data[1600:2400])
+	assert.Equal(t, []DialogueTiming{
+		{Speaker: "alice", Start: 0, End: 100 * time.Millisecond},
+		{Speaker: "bob", Start: 150 * time.Millisecond, End: 350 * time.Millisecond},
+		{Speaker: "alice", Start: 400 * time.Millisecond, End: 500 * time.Millisecond},
+	}, stream.Timeline())
+}
+
+func TestYaTTS_SpeakDialogueError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := NewYaTTS(
+		auth.NewAPITokenAuth("token"),
+		nil,
+		request.OutputFormat(request.OutputFormatLPCM),
+		request.SampleRate(request.OutputSampleRate8k),
+	)
+	client.SetTTSEndpointURL(server.URL)
+
+	stream, err := client.SpeakDialogue(context.Background(), request.DialogueEntity{
+		Turns:    []request.DialogueTurn{{Speaker: "alice", Text: "Привет"}},
+		Speakers: map[string][]request.Option{"alice": nil},
+	})
+	assert.NoError(t, err)
+
+	_, err = ioutil.ReadAll(stream)
+	assert.EqualError(t, err, "unexpected status code: 400")
+	assert.Empty(t, stream.Timeline())
+
+	_, err = client.SpeakDialogue(context.Background(), request.DialogueEntity{})
+	assert.ErrorIs(t, err, request.ErrEmptyDialogue)
+
+	_, err = client.SpeakDialogue(context.Background(), request.DialogueEntity{
+		Turns:    []request.DialogueTurn{{Speaker: "alice", Text: "Привет"}},
+		Speakers: map[string][]request.Option{"alice": nil},
+	}, request.SampleRate(0))
+	assert.ErrorIs(t, err, request.ErrDialogueSampleRate)
+}
+
+func TestYaTTS_SpeakDialogueConversion(t *testing.T) {
+	server := newLPCMServer(t, 1600)
+	defer server.Close()
+
+	dialogue := request.DialogueEntity{
+		Turns: []request.DialogueTurn{
+			{Speaker: "alice", Text: "Привет"},
+			{Speaker: "alice", Text: "Пока"},
+		},
+		Speakers: map[string][]request.Option{"alice": {request.Voice(request.VoiceAlena)}},
+		Gap:      50 * time.Millisecond,
+	}
+
+	t.Run("G.711", func(t *testing.T) {
+		client := NewYaTTS(auth.NewAPITokenAuth("token"), server.Client(), request.OutputFormat(request.OutputFormatULaw))
+		client.SetTTSEndpointURL(server.URL)
+
+		stream, err := client.SpeakDialogue(context.Background(), dialogue)
+		assert.NoError(t, err)
+
+		data, err := ioutil.ReadAll(stream)
+		assert.NoError(t, err)
+
+		voice := audio.EncodeG711(audio.G711ULaw, bytes.Repeat([]byte{1}, 1600))
+		gap := audio.EncodeG711(audio.G711ULaw, make([]byte, 800))
+		assert.Equal(t, append(append(append([]byte(nil), voice...), gap...), voice...), data)
+		assert.Equal(t, []DialogueTiming{
+			{Speaker: "alice", Start: 0, End: 100 * time.Millisecond},
+			{Speaker: "alice", Start: 150 * time.Millisecond, End: 250 * time.Millisecond},
+		}, stream.Timeline())
+	})
+	t.Run("resampled", func(t *testing.T) {
+		client := NewYaTTS(
+			auth.NewAPITokenAuth("token"),
+			server.Client(),
+			request.OutputFormat(request.OutputFormatLPCM),
+			request.SampleRate(22050),
+			request.Resample(true),
+		)
+		client.SetTTSEndpointURL(server.URL)
+
+		stream, err := client.SpeakDialogue(context.Background(), dialogue)
+		assert.NoError(t, err)
+
+		_, err = ioutil.ReadAll(stream)
+		assert.NoError(t, err)
+
+		timeline := stream.Timeline()
+		assert.Len(t, timeline, 2)
+		assert.InDelta(t, 50*time.Millisecond, timeline[1].Start-timeline[0].End, float64(time.Millisecond))
+	})
+}
+
+func TestYaTTS_SpeakDialogueOggOpus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		form, err := url.ParseQuery(string(body))
+		assert.NoError(t, err)
+		assert.Equal(t, "oggopus", form.Get("format"))
+
+		// 100ms of the voice for alena and 200ms for filipp
+		d := 100 * time.Millisecond
+
+		if form.Get("voice") == "filipp" {
+			d = 200 * time.Millisecond
+		}
+
+		assert.NoError(t, oggopus.Silence(w, 1, d))
+	}))
+	defer server.Close()
+
+	client := NewYaTTS(auth.NewAPITokenAuth("token"), server.Client(), request.OutputFormat(request.OutputFormatOggOpus))
+	client.SetTTSEndpointURL(server.URL)
+
+	stream, err := client.SpeakDialogue(context.Background(), request.DialogueEntity{
+		Turns: []request.DialogueTurn{
+			{Speaker: "alice", Text: "Привет"},
+			{Speaker: "bob", Text: "Здравствуй"},
+		},
+		Speakers: map[string][]request.Option{
+			"alice": {request.Voice(request.VoiceAlena)},
+			"bob":   {request.Voice(request.VoiceFilipp)},
+		},
+		Gap: 60 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+
+	info, err := oggopus.Probe(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, 360*time.Millisecond, info.Duration)
+	assert.Equal(t, []DialogueTiming{
+		{Speaker: "alice", Start: 0, End: 100 * time.Millisecond},
+		{Speaker: "bob", Start: 160 * time.Millisecond, End: 360 * time.Millisecond},
+	}, stream.Timeline())
+}
@@ -0,0 +1,121 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lEx0/yatts/request"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrUnknownFileExtension = errors.New("unknown audio file extension")
+	ErrUntaggableFile       = errors.New("tags are not supported by the audio file")
+)
+
+// FileOption post-processes the saved audio file
+type FileOption func(path string) error
+
+// fileFormats maps the extensions of the raw audio files to their output formats
+var fileFormats = map[string]request.Option{
+	".ulaw": request.OutputFormat(request.OutputFormatULaw),
+	".alaw": request.OutputFormat(request.OutputFormatALaw),
+	".pcm":  request.OutputFormat(request.OutputFormatLPCM),
+	".raw":  request.OutputFormat(request.OutputFormatLPCM),
+	".ogg":  request.OutputFormat(request.OutputFormatOggOpus),
+	".opus": request.OutputFormat(request.OutputFormatOggOpus),
+}
+
+// SaveFile synthesizes the entity into the file of the format chosen by the extension:
+// .wav is the wav container of the lpcm or of the G.711 format given in the options,
+// .ulaw and .alaw are the raw G.711, .pcm and .raw are the raw lpcm, .ogg and .opus are the oggopus.
+// The file is removed when the synthesis fails.
+func (y *YaTTS) SaveFile(ctx context.Context, path string, entity request.TextEntity, options ...request.Option) error {
+	return y.SaveFileWith(ctx, path, entity, nil, options...)
+}
+
+// SaveFileWith synthesizes the entity into the file like SaveFile and post-processes the saved file
+// with the file options, the file is removed when the synthesis or the post-processing fails
+func (y *YaTTS) SaveFileWith(
+	ctx context.Context,
+	path string,
+	entity request.TextEntity,
+	fileOptions []FileOption,
+	options ...request.Option,
+) error {
+	ext := strings.ToLower(filepath.Ext(path))
+	format, ok := fileFormats[ext]
+
+	if !ok && ext != ".wav" {
+		return fmt.Errorf("%w %q", ErrUnknownFileExtension, ext)
+	}
+
+	f, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		err = y.writeRaw(ctx, f, entity, appendOptions(options, format)...)
+	} else {
+		_, err = y.WriteWAV(ctx, f, entity, options...)
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	for _, option := range fileOptions {
+		if err != nil {
+			break
+		}
+
+		err = option(path)
+	}
+
+	if err != nil {
+		_ = os.Remove(path)
+	}
+
+	return err
+}
+
+// writeRaw synthesizes the entity into w as it is
+func (y *YaTTS) writeRaw(ctx context.Context, w io.Writer, entity request.TextEntity, options ...request.Option) error {
+	body, _, err := y.speak(ctx, entity, options...)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = body.Close() }()
+
+	_, err = io.Copy(w, body)
+
+	return err
+}
@@ -0,0 +1,127 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/auth"
+	"github.com/lEx0/yatts/request"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestYaTTS_SaveFile(t *testing.T) {
+	server := newLPCMServer(t, 1600)
+	defer server.Close()
+
+	client := NewYaTTS(auth.NewAPITokenAuth("token"), server.Client())
+	client.SetTTSEndpointURL(server.URL)
+
+	dir, err := ioutil.TempDir("", "yatts")
+	assert.NoError(t, err)
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	tests := []struct {
+		name    string
+		options []request.Option
+		size    int
+		header  []byte
+	}{
+		{name: "speech.ulaw", size: 800},
+		{name: "speech.ALAW", size: 800},
+		{name: "speech.pcm", size: 1600},
+		{name: "speech.wav", size: audio.WAVHeaderSize + 1600, header: audio.PCMFormat(48000).Header(1600)},
+		{
+			name:    "alaw.wav",
+			options: []request.Option{request.OutputFormat(request.OutputFormatALaw)},
+			size:    audio.G711ALaw.WAVFormat().HeaderSize() + 800,
+			header:  audio.G711ALaw.WAVFormat().Header(800),
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			path := filepath.Join(dir, entry.name)
+
+			assert.NoError(t, client.SaveFile(context.Background(), path, request.SimpleTextEntity{Text: "Привет"}, entry.options...))
+
+			data, err := ioutil.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Len(t, data, entry.size)
+
+			if entry.header != nil {
+				assert.Equal(t, entry.header, data[:len(entry.header)])
+			}
+		})
+	}
+
+	err = client.SaveFile(context.Background(), filepath.Join(dir, "speech.mp3"), request.SimpleTextEntity{Text: "Привет"})
+	assert.ErrorIs(t, err, ErrUnknownFileExtension)
+
+	path := filepath.Join(dir, "invalid.ulaw")
+	err = client.SaveFile(context.Background(), path, request.SimpleTextEntity{Text: "Привет"}, request.SampleRate(request.OutputSampleRate16k))
+	assert.ErrorIs(t, err, request.ErrInvalidSampleRate)
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestYaTTS_SpeakG711(t *testing.T) {
+	server := newLPCMServer(t, 1600)
+	defer server.Close()
+
+	client := NewYaTTS(auth.NewAPITokenAuth("token"), server.Client(), request.OutputFormat(request.OutputFormatULaw))
+	client.SetTTSEndpointURL(server.URL)
+
+	r, err := client.Resolve(request.SimpleTextEntity{Text: "Привет"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ulaw", r.GetOutputFormat())
+
+	lpcm, err := client.Resolve(request.SimpleTextEntity{Text: "Привет"}, request.OutputFormat(request.OutputFormatLPCM), request.SampleRate(8000))
+	assert.NoError(t, err)
+
+	key, _ := r.CacheKey()
+	lpcmKey, _ := lpcm.CacheKey()
+	assert.NotEqual(t, lpcmKey, key)
+
+	stream, err := client.Speak(context.Background(), request.SimpleTextEntity{Text: "Привет"})
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Close())
+	assert.Equal(t, audio.EncodeG711(audio.G711ULaw, bytes.Repeat([]byte{1}, 1600)), data)
+
+	wav, err := client.SpeakWAV(context.Background(), request.SimpleTextEntity{Text: "Привет"})
+	assert.NoError(t, err)
+
+	data, err = ioutil.ReadAll(wav)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(7), binary.LittleEndian.Uint16(data[20:]))
+}
@@ -0,0 +1,186 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// describeTextLength is the number of runes of the text shown by Describe
+const describeTextLength = 64
+
+type requestJSON struct {
+	Text         string  `json:"text,omitempty"`
+	SSML         string  `json:"ssml,omitempty"`
+	Language     string  `json:"lang,omitempty"`
+	Voice        string  `json:"voice,omitempty"`
+	Speed        float64 `json:"speed,omitempty"`
+	Emotion      string  `json:"emotion,omitempty"`
+	SampleRate   int     `json:"sample_rate,omitempty"`
+	OutputFormat string  `json:"format,omitempty"`
+	FolderID     string  `json:"folder_id,omitempty"`
+}
+
+func (r *Request) GetText() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.Text
+}
+
+func (r *Request) GetSSML() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.SSML
+}
+
+func (r *Request) GetLanguage() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.Language
+}
+
+func (r *Request) GetVoice() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.Voice
+}
+
+func (r *Request) GetSpeed() float64 {
+	if r == nil {
+		return 0
+	}
+
+	return r.Speed
+}
+
+func (r *Request) GetEmotion() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.Emotion
+}
+
+func (r *Request) GetSampleRate() int {
+	if r == nil {
+		return 0
+	}
+
+	return r.SampleRate
+}
+
+func (r *Request) GetOutputFormat() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.OutputFormat
+}
+
+func (r *Request) GetFolderID() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.FolderID
+}
+
+// MarshalJSON encodes the options sent to the API, the processors and the lexicons are already applied to the text
+func (r Request) MarshalJSON() ([]byte, error) {
+	return json.Marshal(requestJSON{
+		Text:         r.Text,
+		SSML:         r.SSML,
+		Language:     r.Language,
+		Voice:        r.Voice,
+		Speed:        r.Speed,
+		Emotion:      r.Emotion,
+		SampleRate:   r.SampleRate,
+		OutputFormat: r.OutputFormat,
+		FolderID:     r.FolderID,
+	})
+}
+
+// Describe returns the one line description for the logs with the shortened text
+func (r Request) Describe() string {
+	v, err := r.form()
+
+	if err != nil {
+		return fmt.Sprintf("invalid request: %s", err)
+	}
+
+	parts := make([]string, 0, len(v))
+
+	for _, key := range []string{"voice", "lang", "emotion", "speed", "format", "sampleRateHertz", "folderId", "text", "ssml"} {
+		value := v.Get(key)
+
+		switch {
+		case value == "":
+			continue
+		case key == "text" || key == "ssml":
+			if runes := []rune(value); len(runes) > describeTextLength {
+				value = string(runes[:describeTextLength]) + "…"
+			}
+
+			value = fmt.Sprintf("%q", value)
+		}
+
+		parts = append(parts, key+"="+value)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Canonical returns the deterministic form body with the sorted keys
+func (r Request) Canonical() ([]byte, error) {
+	v, err := r.form()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(v.Encode()), nil
+}
+
+// CacheKey returns the hex SHA-256 of the canonical encoding
+func (r Request) CacheKey() (string, error) {
+	canonical, err := r.Canonical()
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(canonical)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,93 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestRequest_Getters(t *testing.T) {
+	var r *Request
+
+	assert.Equal(t, "", r.GetVoice())
+	assert.Equal(t, 0, r.GetSampleRate())
+
+	r = &Request{Voice: "jane", Language: "ru-RU", Speed: 1.1, Emotion: "evil", SampleRate: 8000, OutputFormat: "lpcm"}
+
+	assert.Equal(t, "jane", r.GetVoice())
+	assert.Equal(t, "ru-RU", r.GetLanguage())
+	assert.Equal(t, 1.1, r.GetSpeed())
+	assert.Equal(t, "evil", r.GetEmotion())
+	assert.Equal(t, 8000, r.GetSampleRate())
+	assert.Equal(t, "lpcm", r.GetOutputFormat())
+}
+
+func TestRequest_Describe(t *testing.T) {
+	r := Request{
+		Text:         strings.Repeat("а", 70),
+		Voice:        "jane",
+		Language:     "ru-RU",
+		Speed:        1.1,
+		OutputFormat: "lpcm",
+		SampleRate:   8000,
+		Processors:   []TextProcessor{WhitespaceProcessor{}},
+	}
+
+	assert.Equal(t,
+		`voice=jane lang=ru-RU speed=1.1 format=lpcm sampleRateHertz=8000 text="`+strings.Repeat("а", 64)+`…"`,
+		r.Describe(),
+	)
+
+	data, err := json.Marshal(r)
+	assert.NoError(t, err)
+	assert.JSONEq(t,
+		`{"text":"`+strings.Repeat("а", 70)+`","lang":"ru-RU","voice":"jane","speed":1.1,"sample_rate":8000,"format":"lpcm"}`,
+		string(data),
+	)
+}
+
+func TestRequest_CacheKey(t *testing.T) {
+	a := Request{Text: "привет", Voice: "jane", SampleRate: 8000, OutputFormat: "lpcm"}
+	b := Request{OutputFormat: "lpcm", SampleRate: 8000, Voice: "jane", Text: "привет", Processors: []TextProcessor{URLProcessor{}}}
+
+	canonical, err := a.Canonical()
+	assert.NoError(t, err)
+	assert.Equal(t, "format=lpcm&sampleRateHertz=8000&text=%D0%BF%D1%80%D0%B8%D0%B2%D0%B5%D1%82&voice=jane", string(canonical))
+
+	keyA, err := a.CacheKey()
+	assert.NoError(t, err)
+
+	keyB, err := b.CacheKey()
+	assert.NoError(t, err)
+	assert.Equal(t, keyA, keyB)
+	assert.Len(t, keyA, 64)
+
+	b.Voice = "omazh"
+	keyB, _ = b.CacheKey()
+	assert.NotEqual(t, keyA, keyB)
+
+	_, err = Request{}.CacheKey()
+	assert.ErrorIs(t, err, ErrNoSpeakEntity)
+}
@@ -0,0 +1,137 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrEmptyDialogue       = errors.New("empty dialogue")
+	ErrUnknownSpeaker      = errors.New("unknown speaker")
+	ErrInvalidDialogueGap  = errors.New("invalid dialogue gap")
+	ErrDialogueFormat      = errors.New("dialogue requires lpcm, G.711 or ogg opus output format")
+	ErrDialogueFormats     = errors.New("dialogue turns have different output formats")
+	ErrDialogueSampleRates = errors.New("dialogue turns have different sample rates")
+	ErrDialogueSampleRate  = errors.New("dialogue requires lpcm sample rate")
+)
+
+type (
+	// DialogueTurn is a single line of the dialogue
+	DialogueTurn struct {
+		Speaker string
+		Text    string
+		// Options override the speaker options for this turn only
+		Options []Option
+	}
+
+	// DialogueEntity is a script of turns spoken by different speakers
+	DialogueEntity struct {
+		Turns []DialogueTurn
+		// Speakers maps the speaker name to the voice options
+		Speakers map[string][]Option
+		// Gap is the silence inserted between the turns
+		Gap time.Duration
+	}
+)
+
+// Requests resolves the request of every turn, the turn options are applied
+// after the given options and the speaker options. The turns must have the same lpcm, G.711 or ogg opus
+// output format, the lpcm sample rate must be given explicitly.
+func (d DialogueEntity) Requests(options ...Option) ([]*Request, error) {
+	if len(d.Turns) == 0 {
+		return nil, ErrEmptyDialogue
+	} else if d.Gap < 0 {
+		return nil, ErrInvalidDialogueGap
+	}
+
+	result := make([]*Request, 0, len(d.Turns))
+
+	for i, turn := range d.Turns {
+		speaker, ok := d.Speakers[turn.Speaker]
+
+		if !ok {
+			return nil, fmt.Errorf("turn #%d: %w %q", i, ErrUnknownSpeaker, turn.Speaker)
+		}
+
+		r := NewRequest()
+
+		for _, list := range [][]Option{options, speaker, turn.Options} {
+			for _, option := range list {
+				if err := option(r); err != nil {
+					return nil, fmt.Errorf("turn #%d: %w", i, err)
+				}
+			}
+		}
+
+		if err := (SimpleTextEntity{Text: turn.Text}).Process(r); err != nil {
+			return nil, fmt.Errorf("turn #%d: %w", i, err)
+		}
+
+		if err := r.checkDialogue(); err != nil {
+			return nil, fmt.Errorf("turn #%d: %w", i, err)
+		} else if err := r.Validate(); err != nil {
+			return nil, fmt.Errorf("turn #%d: %w", i, err)
+		}
+
+		if len(result) > 0 && result[0].OutputFormat != r.OutputFormat {
+			return nil, fmt.Errorf("turn #%d: %w", i, ErrDialogueFormats)
+		} else if len(result) > 0 && result[0].rawSampleRate() != r.rawSampleRate() {
+			return nil, fmt.Errorf("turn #%d: %w", i, ErrDialogueSampleRates)
+		}
+
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// checkDialogue checks that the audio of the turn can be joined with the others
+func (r Request) checkDialogue() error {
+	switch outputFormat(r.OutputFormat) {
+	case OutputFormatLPCM:
+		if r.SampleRate == 0 {
+			return ErrDialogueSampleRate
+		}
+	case OutputFormatULaw, OutputFormatALaw, OutputFormatOggOpus:
+	default:
+		return ErrDialogueFormat
+	}
+
+	return nil
+}
+
+// rawSampleRate returns the sample rate of the raw output, the G.711 formats are always 8 kHz
+// and the ogg opus streams are joined regardless of the sample rate
+func (r Request) rawSampleRate() int {
+	if r.OutputFormat == string(OutputFormatOggOpus) {
+		return 0
+	}
+
+	if r.OutputFormat == string(OutputFormatULaw) || r.OutputFormat == string(OutputFormatALaw) {
+		return int(OutputSampleRate8k)
+	}
+
+	return r.SampleRate
+}
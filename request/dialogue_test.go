@@ -0,0 +1,143 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDialogueEntity_Requests(t *testing.T) {
+	speakers := map[string][]Option{
+		"alice": {Voice(VoiceAlena), Emotion(EmotionGood)},
+		"bob":   {Voice(VoiceFilipp), Speed(1.2)},
+	}
+
+	t.Run("options are merged per turn", func(t *testing.T) {
+		d := DialogueEntity{
+			Turns: []DialogueTurn{
+				{Speaker: "alice", Text: "Привет"},
+				{Speaker: "bob", Text: "Здравствуй", Options: []Option{Speed(0.8)}},
+			},
+			Speakers: speakers,
+		}
+
+		requests, err := d.Requests(OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate48k), Language(LangRu))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Request{
+			{
+				Text: "Привет", Language: "ru-RU", Voice: "alena", Emotion: "good",
+				OutputFormat: "lpcm", SampleRate: 48000,
+			},
+			{
+				Text: "Здравствуй", Language: "ru-RU", Voice: "filipp", Speed: 0.8,
+				OutputFormat: "lpcm", SampleRate: 48000,
+			},
+		}, requests)
+	})
+	t.Run("invalid dialogues", func(t *testing.T) {
+		type testCase struct {
+			name     string
+			dialogue DialogueEntity
+			err      error
+		}
+
+		tests := []testCase{
+			{name: "no turns", dialogue: DialogueEntity{Speakers: speakers}, err: ErrEmptyDialogue},
+			{
+				name: "negative gap",
+				dialogue: DialogueEntity{
+					Turns: []DialogueTurn{{Speaker: "bob", Text: "a"}}, Speakers: speakers, Gap: -time.Second,
+				},
+				err: ErrInvalidDialogueGap,
+			},
+			{
+				name:     "unknown speaker",
+				dialogue: DialogueEntity{Turns: []DialogueTurn{{Speaker: "eve", Text: "a"}}, Speakers: speakers},
+				err:      ErrUnknownSpeaker,
+			},
+			{
+				name:     "empty text",
+				dialogue: DialogueEntity{Turns: []DialogueTurn{{Speaker: "bob"}}, Speakers: speakers},
+				err:      ErrEmptyTextEntry,
+			},
+			{
+				name: "invalid turn option",
+				dialogue: DialogueEntity{
+					Turns: []DialogueTurn{{Speaker: "bob", Text: "a", Options: []Option{Speed(5)}}}, Speakers: speakers,
+				},
+				err: ErrInvalidSpeakingSpeed,
+			},
+			{
+				name: "unsupported format",
+				dialogue: DialogueEntity{
+					Turns: []DialogueTurn{
+						{Speaker: "bob", Text: "a", Options: []Option{OutputFormat("mp3")}},
+					},
+					Speakers: speakers,
+				},
+				err: ErrDialogueFormat,
+			},
+			{
+				name: "lpcm without sample rate",
+				dialogue: DialogueEntity{
+					Turns:    []DialogueTurn{{Speaker: "bob", Text: "a", Options: []Option{SampleRate(0)}}},
+					Speakers: speakers,
+				},
+				err: ErrDialogueSampleRate,
+			},
+			{
+				name: "different formats",
+				dialogue: DialogueEntity{
+					Turns: []DialogueTurn{
+						{Speaker: "bob", Text: "a", Options: []Option{OutputFormat(OutputFormatULaw), SampleRate(0)}},
+						{Speaker: "bob", Text: "b", Options: []Option{SampleRate(OutputSampleRate8k)}},
+					},
+					Speakers: speakers,
+				},
+				err: ErrDialogueFormats,
+			},
+			{
+				name: "different sample rates",
+				dialogue: DialogueEntity{
+					Turns: []DialogueTurn{
+						{Speaker: "bob", Text: "a"},
+						{Speaker: "bob", Text: "b", Options: []Option{SampleRate(OutputSampleRate8k)}},
+					},
+					Speakers: speakers,
+				},
+				err: ErrDialogueSampleRates,
+			},
+		}
+
+		for _, entry := range tests {
+			t.Run(entry.name, func(t *testing.T) {
+				requests, err := entry.dialogue.Requests(OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate48k))
+
+				assert.ErrorIs(t, err, entry.err)
+				assert.Nil(t, requests)
+			})
+		}
+	})
+}
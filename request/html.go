@@ -0,0 +1,293 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	htmlAttribute = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+
+	// htmlIgnoredElements are never spoken
+	htmlIgnoredElements = map[string]bool{
+		"head": true, "script": true, "style": true, "noscript": true,
+		"template": true, "svg": true, "iframe": true, "object": true,
+	}
+	// htmlVoidElements have no end tag
+	htmlVoidElements = map[string]bool{
+		"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+		"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+	}
+	// htmlBlockElements separate paragraphs
+	htmlBlockElements = map[string]bool{
+		"address": true, "article": true, "aside": true, "blockquote": true, "body": true, "br": true,
+		"dd": true, "details": true, "div": true, "dl": true, "dt": true, "fieldset": true,
+		"figcaption": true, "figure": true, "footer": true, "form": true, "header": true, "hr": true,
+		"main": true, "nav": true, "ol": true, "p": true, "pre": true, "section": true, "summary": true,
+		"table": true, "tr": true, "ul": true, "caption": true,
+	}
+)
+
+type (
+	htmlParser struct {
+		config markupConfig
+		blocks []markupBlock
+		text   strings.Builder
+		// the element which content is skipped and the nesting depth of it
+		skip      string
+		skipDepth int
+		heading   bool
+		pre       int
+		lists     []htmlList
+		items     []htmlItem
+	}
+
+	htmlList struct {
+		ordered bool
+		number  int
+	}
+
+	htmlItem struct {
+		number  int
+		emitted bool
+	}
+
+	htmlTag struct {
+		name    string
+		end     bool
+		closed  bool
+		attrs   map[string]string
+		comment bool
+	}
+)
+
+// parseHTML converts the html document into the speakable blocks
+func parseHTML(src string, config markupConfig) []markupBlock {
+	p := htmlParser{config: config}
+
+	for src != "" {
+		text := src
+
+		if i := strings.IndexByte(src, '<'); i >= 0 {
+			text = src[:i]
+		}
+
+		if p.skip == "" {
+			p.text.WriteString(text)
+		}
+
+		src = src[len(text):]
+
+		if src == "" {
+			break
+		}
+
+		end := markupEnd(src)
+		p.tag(parseHTMLTag(src[:end]))
+		src = src[end:]
+	}
+
+	p.flush()
+
+	return p.blocks
+}
+
+func parseHTMLTag(markup string) htmlTag {
+	if strings.HasPrefix(markup, "<!") || strings.HasPrefix(markup, "<?") {
+		return htmlTag{comment: true}
+	}
+
+	tag := htmlTag{
+		name:   strings.ToLower(markupName(markup)),
+		end:    strings.HasPrefix(markup, "</"),
+		closed: strings.HasSuffix(markup, "/>"),
+		attrs:  map[string]string{},
+	}
+
+	for _, m := range htmlAttribute.FindAllStringSubmatch(markup, -1) {
+		tag.attrs[strings.ToLower(m[1])] = html.UnescapeString(m[2] + m[3] + m[4])
+	}
+
+	return tag
+}
+
+// tag updates the parser state with the start or end tag
+func (p *htmlParser) tag(tag htmlTag) {
+	switch {
+	case tag.comment:
+		return
+	case p.skip != "":
+		p.skipped(tag)
+
+		return
+	case tag.end:
+		p.endTag(tag)
+
+		return
+	case p.ignored(tag.name):
+		if !tag.closed && !htmlVoidElements[tag.name] {
+			p.skip, p.skipDepth = tag.name, 1
+		}
+
+		return
+	}
+
+	if htmlBlockElements[tag.name] || isHTMLHeading(tag.name) || tag.name == "li" {
+		p.flush()
+	}
+
+	switch tag.name {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		p.heading = true
+	case "pre":
+		p.pre++
+	case "ul", "ol":
+		list := htmlList{ordered: tag.name == "ol"}
+
+		if start, err := strconv.Atoi(tag.attrs["start"]); err == nil {
+			list.number = start - 1
+		}
+
+		p.lists = append(p.lists, list)
+	case "li":
+		// the items of the bullet lists and the items outside of lists are not numbered
+		var number int
+
+		if len(p.lists) > 0 && p.lists[len(p.lists)-1].ordered {
+			list := &p.lists[len(p.lists)-1]
+			list.number++
+			number = list.number
+		}
+
+		p.items = append(p.items, htmlItem{number: number})
+	case "td", "th":
+		if p.text.Len() > 0 {
+			p.text.WriteString(", ")
+		}
+	case "img":
+		p.text.WriteString(" " + tag.attrs["alt"] + " ")
+	}
+}
+
+func (p *htmlParser) endTag(tag htmlTag) {
+	if htmlBlockElements[tag.name] || isHTMLHeading(tag.name) || tag.name == "li" {
+		p.flush()
+	}
+
+	switch tag.name {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		p.heading = false
+	case "pre":
+		if p.pre > 0 {
+			p.pre--
+		}
+	case "ul", "ol":
+		if len(p.lists) > 0 {
+			p.lists = p.lists[:len(p.lists)-1]
+		}
+	case "li":
+		if len(p.items) > 0 {
+			p.items = p.items[:len(p.items)-1]
+		}
+	}
+}
+
+// skipped tracks the nesting of the skipped element
+func (p *htmlParser) skipped(tag htmlTag) {
+	if tag.name != p.skip || tag.closed {
+		return
+	}
+
+	if tag.end {
+		p.skipDepth--
+	} else {
+		p.skipDepth++
+	}
+
+	if p.skipDepth == 0 {
+		p.skip = ""
+		p.text.WriteString(" ")
+	}
+}
+
+// ignored reports whether the element content must not be spoken
+func (p *htmlParser) ignored(name string) bool {
+	switch {
+	case htmlIgnoredElements[name]:
+		return true
+	case isHTMLHeading(name):
+		return p.config.skipped(ElementHeading)
+	}
+
+	switch name {
+	case "pre":
+		return p.config.skipped(ElementCodeBlock)
+	case "code", "kbd", "samp":
+		return p.pre == 0 && p.config.skipped(ElementInlineCode)
+	case "img":
+		return p.config.skipped(ElementImage)
+	case "a":
+		return p.config.skipped(ElementLink)
+	case "ul", "ol":
+		return p.config.skipped(ElementList)
+	case "blockquote":
+		return p.config.skipped(ElementBlockquote)
+	case "table":
+		return p.config.skipped(ElementTable)
+	}
+
+	return false
+}
+
+// flush turns the accumulated text into a block
+func (p *htmlParser) flush() {
+	text := collapseSpaces(html.UnescapeString(p.text.String()))
+	p.text.Reset()
+
+	if text == "" {
+		return
+	}
+
+	block := markupBlock{kind: blockParagraph, text: text}
+
+	switch {
+	case p.heading:
+		block.kind = blockHeading
+	case len(p.items) > 0:
+		item := &p.items[len(p.items)-1]
+		block.kind = blockListItem
+
+		if !item.emitted {
+			block.number, item.emitted = item.number, true
+		}
+	}
+
+	p.blocks = append(p.blocks, block)
+}
+
+func isHTMLHeading(name string) bool {
+	return len(name) == 2 && name[0] == 'h' && name[1] >= '1' && name[1] <= '6'
+}
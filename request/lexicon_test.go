@@ -0,0 +1,161 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNewLexicon(t *testing.T) {
+	type testCase struct {
+		name  string
+		entry LexiconEntry
+		valid bool
+	}
+
+	tests := []testCase{
+		{name: "word with replacement", entry: LexiconEntry{Word: "ya", Replacement: "яндекс"}, valid: true},
+		{name: "pattern with phoneme", entry: LexiconEntry{Pattern: `\d+`, Phoneme: "ʂ"}, valid: true},
+		{name: "no word and pattern", entry: LexiconEntry{Replacement: "foo"}},
+		{name: "both word and pattern", entry: LexiconEntry{Word: "a", Pattern: "a", Alias: "b"}},
+		{name: "no replacement", entry: LexiconEntry{Word: "a"}},
+		{name: "both alias and phoneme", entry: LexiconEntry{Word: "a", Alias: "b", Phoneme: "c"}},
+		{name: "invalid pattern", entry: LexiconEntry{Pattern: "(", Alias: "b"}},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			l, err := NewLexicon(entry.entry)
+
+			if entry.valid {
+				assert.NoError(t, err)
+				assert.NotNil(t, l)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidLexiconEntry)
+				assert.Nil(t, l)
+			}
+		})
+	}
+}
+
+func TestLoadLexicon(t *testing.T) {
+	f, err := ioutil.TempFile("", "lexicon*.json")
+	assert.NoError(t, err)
+
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	_, err = f.WriteString(`[{"word": "SpeechKit", "replacement": "спичк+ит"}, {"pattern": "(\\d+) ?ГБ", "replacement": "$1 гигабайт"}]`)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	l, err := LoadLexicon(f.Name())
+	assert.NoError(t, err)
+	assert.Len(t, l.entries, 2)
+
+	_, err = LoadLexicon(f.Name() + ".missing")
+	assert.Error(t, err)
+
+	_, err = ReadLexicon(strings.NewReader(`{"word": "foo"}`))
+	assert.Error(t, err)
+}
+
+func TestLexicons(t *testing.T) {
+	l, err := NewLexicon(
+		LexiconEntry{Word: "SpeechKit", Replacement: "спичк+ит"},
+		LexiconEntry{Word: "ИП", CaseSensitive: true, Alias: "индивидуальный предприниматель"},
+		LexiconEntry{Pattern: `(\d+) ?ГБ`, Replacement: "$1 гигабайт"},
+		LexiconEntry{Word: "tomato", Phoneme: "təˈmɑːtəʊ"},
+	)
+	assert.NoError(t, err)
+
+	type (
+		in struct {
+			entity TextEntity
+		}
+		testCase struct {
+			name     string
+			in       in
+			expected Request
+		}
+	)
+
+	tests := []testCase{
+		{
+			name:     "replacement with case folding",
+			in:       in{entity: SimpleTextEntity{Text: "speechkit и SPEECHKIT, но не speechkits"}},
+			expected: Request{Text: "спичк+ит и спичк+ит, но не speechkits"},
+		},
+		{
+			name:     "pattern with groups",
+			in:       in{entity: SimpleTextEntity{Text: "диск на 512ГБ и 1 ГБ"}},
+			expected: Request{Text: "диск на 512 гигабайт и 1 гигабайт"},
+		},
+		{
+			name:     "case sensitive cyrillic word boundaries",
+			in:       in{entity: SimpleTextEntity{Text: "ип ИПОТЕКА"}},
+			expected: Request{Text: "ип ИПОТЕКА"},
+		},
+		{
+			name: "text is converted into ssml",
+			in:   in{entity: SimpleTextEntity{Text: "ИП & tomato"}},
+			expected: Request{SSML: `<speak><sub alias="индивидуальный предприниматель">ИП</sub> &amp; ` +
+				`<phoneme alphabet="ipa" ph="təˈmɑːtəʊ">tomato</phoneme></speak>`},
+		},
+		{
+			name: "ssml text nodes",
+			in: in{entity: SSMLTextEntity{
+				SSML: `<speak>ИП <sub alias="tomato">ИП</sub> <break time="1s"/>tomato</speak>`,
+			}},
+			expected: Request{SSML: `<speak><sub alias="индивидуальный предприниматель">ИП</sub> ` +
+				`<sub alias="tomato">ИП</sub> <break time="1s"/>` +
+				`<phoneme alphabet="ipa" ph="təˈmɑːtəʊ">tomato</phoneme></speak>`},
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			r := Request{}
+
+			assert.NoError(t, Lexicons(l)(&r))
+			assert.NoError(t, entry.in.entity.Process(&r))
+
+			entry.expected.Lexicons = []*Lexicon{l}
+			assert.Equal(t, entry.expected, r)
+		})
+	}
+}
+
+func TestLexicons_EmptyMatches(t *testing.T) {
+	l, err := NewLexicon(LexiconEntry{Pattern: "a*", Replacement: "x"})
+	assert.NoError(t, err)
+
+	for text, expected := range map[string]string{"b": "b", "b aa": "b x", "aa ba a": "x ba x"} {
+		r := Request{Lexicons: []*Lexicon{l}}
+
+		assert.NoError(t, SimpleTextEntity{Text: text}.Process(&r))
+		assert.Equal(t, expected, r.Text)
+	}
+}
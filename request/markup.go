@@ -0,0 +1,179 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type (
+	// MarkupOption configures the conversion of Markdown and HTML documents
+	MarkupOption func(c *markupConfig)
+
+	markupElement string
+	markupKind    int
+
+	markupConfig struct {
+		skip           map[markupElement]bool
+		headingPause   time.Duration
+		paragraphPause time.Duration
+	}
+
+	markupBlock struct {
+		kind markupKind
+		text string
+		// number of the list item, zero for the continuation of the item
+		number int
+	}
+)
+
+const (
+	ElementCodeBlock  markupElement = "code_block"
+	ElementInlineCode markupElement = "inline_code"
+	ElementImage      markupElement = "image"
+	ElementLink       markupElement = "link"
+	ElementHeading    markupElement = "heading"
+	ElementList       markupElement = "list"
+	ElementBlockquote markupElement = "blockquote"
+	ElementTable      markupElement = "table"
+
+	defaultHeadingPause = 700 * time.Millisecond
+)
+
+const (
+	blockParagraph markupKind = iota
+	blockHeading
+	blockListItem
+)
+
+// SkipElements drops the elements from the spoken text.
+// Code blocks and images are skipped by default.
+func SkipElements(elements ...markupElement) MarkupOption {
+	return func(c *markupConfig) {
+		for _, element := range elements {
+			c.skip[element] = true
+		}
+	}
+}
+
+// KeepElements speaks the elements which are skipped by default,
+// the alternative text is spoken for images
+func KeepElements(elements ...markupElement) MarkupOption {
+	return func(c *markupConfig) {
+		for _, element := range elements {
+			delete(c.skip, element)
+		}
+	}
+}
+
+// HeadingPause sets the pause after headings
+func HeadingPause(pause time.Duration) MarkupOption {
+	return func(c *markupConfig) {
+		c.headingPause = pause
+	}
+}
+
+// ParagraphPause sets the pause added after paragraphs and list items
+// on top of the pause of the SSML <p> element
+func ParagraphPause(pause time.Duration) MarkupOption {
+	return func(c *markupConfig) {
+		c.paragraphPause = pause
+	}
+}
+
+// NewMarkdownEntity converts the Markdown document into the SSML entity
+func NewMarkdownEntity(markdown string, options ...MarkupOption) SSMLTextEntity {
+	config := newMarkupConfig(options...)
+
+	return renderMarkup(parseMarkdown(markdown, config), config)
+}
+
+// NewHTMLEntity converts the HTML document into the SSML entity
+func NewHTMLEntity(document string, options ...MarkupOption) SSMLTextEntity {
+	config := newMarkupConfig(options...)
+
+	return renderMarkup(parseHTML(document, config), config)
+}
+
+func newMarkupConfig(options ...MarkupOption) markupConfig {
+	config := markupConfig{
+		skip: map[markupElement]bool{
+			ElementCodeBlock: true,
+			ElementImage:     true,
+		},
+		headingPause: defaultHeadingPause,
+	}
+
+	for _, option := range options {
+		option(&config)
+	}
+
+	return config
+}
+
+func (c markupConfig) skipped(element markupElement) bool {
+	return c.skip[element]
+}
+
+// renderMarkup renders the blocks as paragraphs of the SSML document,
+// the entity is empty when there is nothing to speak
+func renderMarkup(blocks []markupBlock, config markupConfig) SSMLTextEntity {
+	var result strings.Builder
+
+	for _, block := range blocks {
+		text := xmlTextEscaper.Replace(collapseSpaces(block.text))
+
+		if text == "" {
+			continue
+		}
+
+		if block.kind == blockListItem && block.number > 0 {
+			text = fmt.Sprintf("%d. %s", block.number, text)
+		}
+
+		result.WriteString("<p>" + text + "</p>")
+
+		if block.kind == blockHeading {
+			writeBreak(&result, config.headingPause)
+		} else {
+			writeBreak(&result, config.paragraphPause)
+		}
+	}
+
+	if result.Len() == 0 {
+		return SSMLTextEntity{}
+	}
+
+	return SSMLTextEntity{SSML: "<speak>" + result.String() + "</speak>"}
+}
+
+func writeBreak(result *strings.Builder, pause time.Duration) {
+	if pause > 0 {
+		_, _ = fmt.Fprintf(result, `<break time="%dms"/>`, pause.Milliseconds())
+	}
+}
+
+func collapseSpaces(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,165 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewMarkdownEntity(t *testing.T) {
+	type (
+		in struct {
+			markdown string
+			options  []MarkupOption
+		}
+		testCase struct {
+			name string
+			in   in
+			out  string
+		}
+	)
+
+	tests := []testCase{
+		{
+			name: "headings and paragraphs",
+			in:   in{markdown: "# Title\n\nFirst line\nsecond line\n\nSubtitle\n--------\nText"},
+			out: `<speak><p>Title</p><break time="700ms"/><p>First line second line</p>` +
+				`<p>Subtitle</p><break time="700ms"/><p>Text</p></speak>`,
+		},
+		{
+			name: "inline markup",
+			in: in{markdown: "Some **bold**, *italic* and ~~old~~ text with [link](https://ya.ru), " +
+				"![image](a.png) <https://ya.ru> and `code`. snake_case \\*stars\\* AT&T"},
+			out: `<speak><p>Some bold, italic and old text with link, and code. snake_case *stars* AT&amp;T</p></speak>`,
+		},
+		{
+			name: "code blocks are skipped",
+			in:   in{markdown: "before\n\n```go\nfmt.Println()\n```\n\n    indented()\n\nafter"},
+			out:  `<speak><p>before</p><p>after</p></speak>`,
+		},
+		{
+			name: "ordered lists are numbered",
+			in:   in{markdown: "- one\n- two\n  continued\n  - nested\n- three\n\n3. third\n4. fourth"},
+			out: `<speak><p>one</p><p>two continued</p><p>nested</p><p>three</p>` +
+				`<p>3. third</p><p>4. fourth</p></speak>`,
+		},
+		{
+			name: "rule ends the list item",
+			in:   in{markdown: "- item\n---\nafter the rule"},
+			out:  `<speak><p>item</p><p>after the rule</p></speak>`,
+		},
+		{
+			name: "quotes, tables and references",
+			in:   in{markdown: "> quote\n\n| a | b |\n|---|:-:|\n| 1 | 2 |\n\n[ref]: https://ya.ru"},
+			out:  `<speak><p>quote</p><p>a, b</p><p>1, 2</p></speak>`,
+		},
+		{
+			name: "skip and keep elements",
+			in: in{
+				markdown: "# Title\n\n- item\n\n[link](x) ![alt text](a.png) `code`\n\n```\ncode()\n```",
+				options: []MarkupOption{
+					SkipElements(ElementHeading, ElementList, ElementLink, ElementInlineCode),
+					KeepElements(ElementImage, ElementCodeBlock),
+				},
+			},
+			out: `<speak><p>alt text</p><p>code()</p></speak>`,
+		},
+		{
+			name: "pauses",
+			in: in{
+				markdown: "# Title\n\ntext",
+				options:  []MarkupOption{HeadingPause(time.Second), ParagraphPause(300 * time.Millisecond)},
+			},
+			out: `<speak><p>Title</p><break time="1000ms"/><p>text</p><break time="300ms"/></speak>`,
+		},
+		{
+			name: "nothing to speak",
+			in:   in{markdown: "```\ncode()\n```"},
+			out:  "",
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			entity := NewMarkdownEntity(entry.in.markdown, entry.in.options...)
+
+			assert.Equal(t, entry.out, entity.SSML)
+
+			if entry.out != "" {
+				assert.NoError(t, entity.Process(&Request{}))
+			}
+		})
+	}
+}
+
+func TestNewHTMLEntity(t *testing.T) {
+	type (
+		in struct {
+			document string
+			options  []MarkupOption
+		}
+		testCase struct {
+			name string
+			in   in
+			out  string
+		}
+	)
+
+	tests := []testCase{
+		{
+			name: "document",
+			in: in{document: `<html><head><title>x</title><style>p {}</style></head><body>` +
+				`<h1>Title</h1><p>Hello <b>world</b> &amp; <a href="https://ya.ru">link</a> <img src="a.png" alt="pic"></p>` +
+				`<pre><code>code()</code></pre><script>alert(1)</script><!-- comment --></body></html>`},
+			out: `<speak><p>Title</p><break time="700ms"/><p>Hello world &amp; link</p></speak>`,
+		},
+		{
+			name: "lists and tables",
+			in: in{document: `<ol start="3"><li>three</li><li><p>four</p><p>more</p></li></ol><ul><li>a<br>b</li></ul>` +
+				`<table><tr><th>a</th><th>b</th></tr><tr><td>1</td><td>2</td></tr></table>`},
+			out: `<speak><p>3. three</p><p>4. four</p><p>more</p><p>a</p><p>b</p><p>a, b</p><p>1, 2</p></speak>`,
+		},
+		{
+			name: "skip and keep elements",
+			in: in{
+				document: `<h2>Title</h2><ul><li>item</li></ul><p><a href="x">link</a> <img alt="alt text"> ` +
+					`<code>code</code></p><pre>code()</pre><table><tr><td>1</td></tr></table>`,
+				options: []MarkupOption{
+					SkipElements(ElementHeading, ElementList, ElementLink, ElementInlineCode, ElementTable),
+					KeepElements(ElementImage, ElementCodeBlock),
+				},
+			},
+			out: `<speak><p>alt text</p><p>code()</p></speak>`,
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			entity := NewHTMLEntity(entry.in.document, entry.in.options...)
+
+			assert.Equal(t, entry.out, entity.SSML)
+			assert.NoError(t, entity.Process(&Request{}))
+		})
+	}
+}
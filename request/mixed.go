@@ -0,0 +1,300 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var ErrUnroutedLanguage = errors.New("no voice speaks the language")
+
+type (
+	// MixedLanguageEntity is a text which contains phrases in several languages,
+	// every run of the text is spoken by the voice routed for its language
+	MixedLanguageEntity struct {
+		Text string
+		// Voices maps the language to the voice options of its runs. The runs of the languages without
+		// a route are spoken by the requested voice when it speaks their language and by the first voice
+		// of the catalog speaking it otherwise.
+		Voices map[lang][]Option
+		// Cyrillic is the language of the cyrillic words without language specific letters, LangRu by default
+		Cyrillic lang
+		// Latin is the language of the latin words without language specific letters, LangEn by default
+		Latin lang
+	}
+
+	// LanguageRun is a part of the text written in a single language
+	LanguageRun struct {
+		Language lang
+		Text     string
+	}
+
+	languageToken struct {
+		text   string
+		word   bool
+		script *unicode.RangeTable
+		// language detected by the specific letters, empty for ambiguous words
+		language lang
+	}
+)
+
+const (
+	kazakhLetters        = "әғқңөұүһі"
+	uzbekCyrillicLetters = "ўҳ"
+	// wordApostrophes are kept inside the words, uzbekApostrophes are the letters of the Uzbek latin alphabet
+	// and uzbekDigraphApostrophes stand for them only in oʻ and gʻ
+	wordApostrophes         = "ʻʼ'’`"
+	uzbekApostrophes        = "ʻʼ"
+	uzbekDigraphApostrophes = "'’"
+	germanLetters           = "äöüß"
+)
+
+// Runs splits the text into the runs of the routed languages
+func (e MixedLanguageEntity) Runs() []LanguageRun {
+	tokens := tokenizeLanguages(e.Text)
+	e.resolve(tokens)
+
+	var (
+		runs   []LanguageRun
+		prefix string
+	)
+
+	for _, token := range tokens {
+		switch last := len(runs) - 1; {
+		case last < 0 && !token.word:
+			prefix += token.text
+		case last < 0:
+			runs = append(runs, LanguageRun{Language: token.language, Text: prefix + token.text})
+		case !token.word || runs[last].Language == token.language:
+			runs[last].Text += token.text
+		default:
+			runs = append(runs, LanguageRun{Language: token.language, Text: token.text})
+		}
+	}
+
+	if len(runs) == 0 {
+		runs = append(runs, LanguageRun{Language: e.scriptLanguage(unicode.Cyrillic), Text: prefix})
+	}
+
+	result := runs[:0]
+
+	for _, run := range runs {
+		if run.Text = strings.TrimSpace(run.Text); run.Text != "" {
+			result = append(result, run)
+		}
+	}
+
+	return result
+}
+
+// Dialogue turns the runs into the dialogue spoken by the routed voices
+func (e MixedLanguageEntity) Dialogue() DialogueEntity {
+	dialogue := DialogueEntity{Speakers: map[string][]Option{}}
+
+	for _, run := range e.Runs() {
+		dialogue.Turns = append(dialogue.Turns, DialogueTurn{Speaker: string(run.Language), Text: run.Text})
+		speaker := []Option{Language(run.Language)}
+
+		if route, ok := e.Voices[run.Language]; ok {
+			speaker = append(speaker, route...)
+		} else {
+			speaker = append(speaker, catalogVoice(run.Language))
+		}
+
+		dialogue.Speakers[string(run.Language)] = speaker
+	}
+
+	return dialogue
+}
+
+// catalogVoice keeps the requested voice when it speaks the language and picks the first voice
+// of the catalog speaking it otherwise
+func catalogVoice(language lang) Option {
+	return func(req *Request) error {
+		if VoiceName(req.Voice).Speaks(language) {
+			return nil
+		}
+
+		for _, v := range VoicesFor(language) {
+			if v.SupportedIn(APIVersion1) {
+				req.Voice = string(v)
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w %q", ErrUnroutedLanguage, language)
+	}
+}
+
+// resolve assigns the routed language to every word token
+func (e MixedLanguageEntity) resolve(tokens []languageToken) {
+	for start := 0; start < len(tokens); {
+		end := start
+
+		for end < len(tokens) && !isSentenceEnd(tokens[end]) {
+			end++
+		}
+
+		if end < len(tokens) {
+			end++
+		}
+
+		sentence := tokens[start:end]
+
+		for i := range sentence {
+			if !sentence[i].word {
+				continue
+			}
+
+			language := sentence[i].language
+
+			if language == "" {
+				language = sentenceLanguage(sentence, sentence[i].script)
+			}
+
+			if _, ok := e.Voices[language]; !ok || language == "" {
+				language = e.scriptLanguage(sentence[i].script)
+			}
+
+			sentence[i].language = language
+		}
+
+		start = end
+	}
+}
+
+// scriptLanguage returns the default language of the script
+func (e MixedLanguageEntity) scriptLanguage(script *unicode.RangeTable) lang {
+	if script == unicode.Latin {
+		if e.Latin != "" {
+			return e.Latin
+		}
+
+		return LangEn
+	}
+
+	if e.Cyrillic != "" {
+		return e.Cyrillic
+	}
+
+	return LangRu
+}
+
+// sentenceLanguage returns the first language detected by the specific letters
+// among the words of the same script
+func sentenceLanguage(sentence []languageToken, script *unicode.RangeTable) lang {
+	for _, token := range sentence {
+		if token.word && token.script == script && token.language != "" {
+			return token.language
+		}
+	}
+
+	return ""
+}
+
+// tokenizeLanguages splits the text into words and separators
+func tokenizeLanguages(text string) []languageToken {
+	var (
+		tokens []languageToken
+		runes  = []rune(text)
+	)
+
+	for i := 0; i < len(runes); {
+		j := i
+
+		if isLanguageLetter(runes[i]) {
+			for j < len(runes) && (isLanguageLetter(runes[j]) ||
+				strings.ContainsRune(wordApostrophes, runes[j]) && j+1 < len(runes) && isLanguageLetter(runes[j+1])) {
+				j++
+			}
+
+			tokens = append(tokens, newWordToken(string(runes[i:j])))
+		} else {
+			for j < len(runes) && !isLanguageLetter(runes[j]) {
+				j++
+			}
+
+			tokens = append(tokens, languageToken{text: string(runes[i:j])})
+		}
+
+		i = j
+	}
+
+	return tokens
+}
+
+func newWordToken(word string) languageToken {
+	token := languageToken{text: word, word: true, script: unicode.Cyrillic}
+	lower := strings.ToLower(word)
+
+	for _, r := range lower {
+		if unicode.Is(unicode.Latin, r) {
+			token.script = unicode.Latin
+
+			break
+		}
+	}
+
+	switch {
+	case strings.ContainsAny(lower, uzbekCyrillicLetters):
+		token.language = LangUZ
+	case token.script == unicode.Cyrillic && strings.ContainsAny(lower, kazakhLetters):
+		token.language = LangKK
+	case token.script == unicode.Latin && isUzbekLatin(word):
+		token.language = LangUZ
+	case token.script == unicode.Latin && strings.ContainsAny(lower, germanLetters):
+		token.language = LangDE
+	}
+
+	return token
+}
+
+// isUzbekLatin reports whether the latin word is spelled with the Uzbek apostrophes, the ASCII and typographic ones
+// count only after o or g followed by a lowercase letter, so the contractions and names like don't or O’Brien do not
+func isUzbekLatin(word string) bool {
+	if strings.ContainsAny(word, uzbekApostrophes) {
+		return true
+	}
+
+	runes := []rune(word)
+
+	for i := 1; i+1 < len(runes); i++ {
+		if strings.ContainsRune(uzbekDigraphApostrophes, runes[i]) && strings.ContainsRune("oOgG", runes[i-1]) &&
+			unicode.IsLower(runes[i+1]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isLanguageLetter(r rune) bool {
+	return unicode.Is(unicode.Cyrillic, r) || unicode.Is(unicode.Latin, r)
+}
+
+func isSentenceEnd(token languageToken) bool {
+	return !token.word && strings.ContainsAny(token.text, ".!?…\n")
+}
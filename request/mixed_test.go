@@ -0,0 +1,174 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMixedLanguageEntity_Runs(t *testing.T) {
+	voices := map[lang][]Option{
+		LangRu: {Voice(VoiceAlena)},
+		LangKK: {Voice(VoiceAmira)},
+		LangEn: {Voice(VoiceJohn)},
+		LangUZ: {Voice(VoiceNigora)},
+	}
+
+	type (
+		in struct {
+			text   string
+			entity MixedLanguageEntity
+		}
+		testCase struct {
+			name string
+			in   in
+			out  []LanguageRun
+		}
+	)
+
+	tests := []testCase{
+		{
+			name: "kazakh sentence with latin brand",
+			in:   in{text: "Бұл компания Google Cloud қолданады."},
+			out: []LanguageRun{
+				{Language: LangKK, Text: "Бұл компания"},
+				{Language: LangEn, Text: "Google Cloud"},
+				{Language: LangKK, Text: "қолданады."},
+			},
+		},
+		{
+			name: "russian sentence after kazakh one",
+			in:   in{text: "Сәлеметсіз бе! Как дела?"},
+			out: []LanguageRun{
+				{Language: LangKK, Text: "Сәлеметсіз бе!"},
+				{Language: LangRu, Text: "Как дела?"},
+			},
+		},
+		{
+			name: "uzbek latin with apostrophes",
+			in: in{
+				text:   "Oʻzbekiston poytaxti Toshkent. Hello world",
+				entity: MixedLanguageEntity{Latin: LangEn},
+			},
+			out: []LanguageRun{
+				{Language: LangUZ, Text: "Oʻzbekiston poytaxti Toshkent."},
+				{Language: LangEn, Text: "Hello world"},
+			},
+		},
+		{
+			name: "uzbek latin with ascii and typographic apostrophes",
+			in: in{
+				text:   "Men o'zbek tilida gapiraman. Bu to’g’ri.",
+				entity: MixedLanguageEntity{Latin: LangEn},
+			},
+			out: []LanguageRun{{Language: LangUZ, Text: "Men o'zbek tilida gapiraman. Bu to’g’ri."}},
+		},
+		{
+			name: "english contractions and names",
+			in:   in{text: "Привет! I don't know, it's O’Brien's `cause."},
+			out: []LanguageRun{
+				{Language: LangRu, Text: "Привет!"},
+				{Language: LangEn, Text: "I don't know, it's O’Brien's `cause."},
+			},
+		},
+		{
+			name: "uzbek cyrillic",
+			in:   in{text: "Ўзбекистон — гўзал юрт"},
+			out:  []LanguageRun{{Language: LangUZ, Text: "Ўзбекистон — гўзал юрт"}},
+		},
+		{
+			name: "language without route falls back to the script default",
+			in:   in{text: "Привет, Straße und Grüße"},
+			out: []LanguageRun{
+				{Language: LangRu, Text: "Привет,"},
+				{Language: LangEn, Text: "Straße und Grüße"},
+			},
+		},
+		{
+			name: "configured script defaults",
+			in: in{
+				text:   "«Сен» and 42",
+				entity: MixedLanguageEntity{Cyrillic: LangKK, Latin: LangUZ},
+			},
+			out: []LanguageRun{
+				{Language: LangKK, Text: "«Сен»"},
+				{Language: LangUZ, Text: "and 42"},
+			},
+		},
+		{
+			name: "text without letters",
+			in:   in{text: " 42 "},
+			out:  []LanguageRun{{Language: LangRu, Text: "42"}},
+		},
+		{
+			name: "empty text",
+			in:   in{text: ""},
+			out:  []LanguageRun{},
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			entity := entry.in.entity
+			entity.Text = entry.in.text
+
+			if entity.Voices == nil {
+				entity.Voices = voices
+			}
+
+			assert.Equal(t, entry.out, entity.Runs())
+		})
+	}
+}
+
+func TestMixedLanguageEntity_Dialogue(t *testing.T) {
+	d := MixedLanguageEntity{
+		Text: "Бұл Google",
+		Voices: map[lang][]Option{
+			LangKK: {Voice(VoiceAmira)},
+			LangEn: {Voice(VoiceJohn)},
+		},
+	}.Dialogue()
+
+	requests, err := d.Requests(OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate48k))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*Request{
+		{Text: "Бұл", Language: "kk-KK", Voice: "amira", OutputFormat: "lpcm", SampleRate: 48000},
+		{Text: "Google", Language: "en-US", Voice: "john", OutputFormat: "lpcm", SampleRate: 48000},
+	}, requests)
+}
+
+func TestMixedLanguageEntity_DialogueUnrouted(t *testing.T) {
+	d := MixedLanguageEntity{
+		Text:   "Привет. Hello.",
+		Voices: map[lang][]Option{LangKK: {Voice(VoiceAmira)}},
+	}.Dialogue()
+
+	requests, err := d.Requests(Voice(VoiceJane), OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate48k))
+
+	assert.NoError(t, err)
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "jane", requests[0].Voice)
+	assert.Equal(t, "john", requests[1].Voice)
+}
@@ -22,18 +22,20 @@
 package request
 
 type (
-	Option func(req *request) error
+	Option func(req *Request) error
 
 	lang             string
-	voice            string
 	outputFormat     string
 	outputSampleRate int
 	emotion          string
+
+	// VoiceName is the name of the voice, the catalog describes the known voices
+	VoiceName string
 )
 
-//goland:noinspection GoExportedFuncWithUnexportedType
-func NewRequest() *request {
-	return &request{}
+// NewRequest creates the empty request the options are applied to
+func NewRequest() *Request {
+	return &Request{}
 }
 
 // voice details
@@ -45,18 +47,18 @@ const (
 	LangDE lang = "de-DE"
 	LangUZ lang = "uz-UZ"
 
-	VoiceJane    voice = "jane"
-	VoiceOmazh   voice = "omazh"
-	VoiceZahar   voice = "zahar"
-	VoiceErmil   voice = "ermil"
-	VoiceAlena   voice = "alena"
-	VoiceFilipp  voice = "filipp"
-	VoiceAmira   voice = "amira"
-	VoiceMadi    voice = "madi"
-	VoiceMadiRus voice = "madirus"
-	VoiceNigora  voice = "nigora"
-	VoiceLea     voice = "lea"
-	VoiceJohn    voice = "john"
+	VoiceJane    VoiceName = "jane"
+	VoiceOmazh   VoiceName = "omazh"
+	VoiceZahar   VoiceName = "zahar"
+	VoiceErmil   VoiceName = "ermil"
+	VoiceAlena   VoiceName = "alena"
+	VoiceFilipp  VoiceName = "filipp"
+	VoiceAmira   VoiceName = "amira"
+	VoiceMadi    VoiceName = "madi"
+	VoiceMadiRus VoiceName = "madirus"
+	VoiceNigora  VoiceName = "nigora"
+	VoiceLea     VoiceName = "lea"
+	VoiceJohn    VoiceName = "john"
 
 	EmotionNone    emotion = ""
 	EmotionNeutral emotion = "neutral"
@@ -65,28 +67,35 @@ const (
 
 	OutputFormatLPCM    outputFormat = "lpcm"
 	OutputFormatOggOpus outputFormat = "oggopus"
+	// OutputFormatULaw and OutputFormatALaw are the G.711 8 kHz telephony formats,
+	// the client requests the lpcm and encodes it locally
+	OutputFormatULaw outputFormat = "ulaw"
+	OutputFormatALaw outputFormat = "alaw"
 
 	OutputSampleRate8k  outputSampleRate = 8000
 	OutputSampleRate16k outputSampleRate = 16000
 	OutputSampleRate48k outputSampleRate = 48000
+
+	// DefaultSampleRate is the sample rate of the lpcm output when it is not specified
+	DefaultSampleRate = int(OutputSampleRate48k)
 )
 
 func Language(name lang) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		req.Language = string(name)
 		return nil
 	}
 }
 
-func Voice(name voice) Option {
-	return func(req *request) error {
+func Voice(name VoiceName) Option {
+	return func(req *Request) error {
 		req.Voice = string(name)
 		return nil
 	}
 }
 
 func Speed(speed float64) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		if speed < 0.1 || speed > 3 {
 			return ErrInvalidSpeakingSpeed
 		}
@@ -98,29 +107,39 @@ func Speed(speed float64) Option {
 }
 
 func Emotion(name emotion) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		req.Emotion = string(name)
 		return nil
 	}
 }
 
 func OutputFormat(name outputFormat) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		req.OutputFormat = string(name)
 		return nil
 	}
 }
 
 func SampleRate(rate outputSampleRate) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		req.SampleRate = int(rate)
 
 		return nil
 	}
 }
 
+// Resample enables the lpcm sample rates the API does not offer, such as 22050 or 44100,
+// the audio is synthesized at the closest higher offered rate and resampled locally
+func Resample(enabled bool) Option {
+	return func(req *Request) error {
+		req.Resample = enabled
+
+		return nil
+	}
+}
+
 func FolderID(id string) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		req.FolderID = id
 
 		return nil
@@ -30,13 +30,13 @@ func TestLanguage(t *testing.T) {
 	type (
 		in struct {
 			language lang
-			request  request
+			request  Request
 		}
 		testCase struct {
 			name   string
 			in     in
 			want   error
-			result request
+			result Request
 		}
 	)
 
@@ -45,12 +45,12 @@ func TestLanguage(t *testing.T) {
 			name: "set russian language",
 			in: in{
 				language: LangRu,
-				request: request{
+				request: Request{
 					SampleRate: 8000,
 				},
 			},
 			want: nil,
-			result: request{
+			result: Request{
 				SampleRate: 8000,
 				Language:   "ru-RU",
 			},
@@ -59,12 +59,12 @@ func TestLanguage(t *testing.T) {
 			name: "set english language",
 			in: in{
 				language: LangEn,
-				request: request{
+				request: Request{
 					SampleRate: 8000,
 				},
 			},
 			want: nil,
-			result: request{
+			result: Request{
 				SampleRate: 8000,
 				Language:   "en-US",
 			},
@@ -73,12 +73,12 @@ func TestLanguage(t *testing.T) {
 			name: "set kazakh language",
 			in: in{
 				language: LangKK,
-				request: request{
+				request: Request{
 					SampleRate: 8000,
 				},
 			},
 			want: nil,
-			result: request{
+			result: Request{
 				SampleRate: 8000,
 				Language:   "kk-KK",
 			},
@@ -87,12 +87,12 @@ func TestLanguage(t *testing.T) {
 			name: "set deutsche language",
 			in: in{
 				language: LangDE,
-				request: request{
+				request: Request{
 					SampleRate: 8000,
 				},
 			},
 			want: nil,
-			result: request{
+			result: Request{
 				SampleRate: 8000,
 				Language:   "de-DE",
 			},
@@ -101,12 +101,12 @@ func TestLanguage(t *testing.T) {
 			name: "set deutsche language",
 			in: in{
 				language: LangUZ,
-				request: request{
+				request: Request{
 					SampleRate: 8000,
 				},
 			},
 			want: nil,
-			result: request{
+			result: Request{
 				SampleRate: 8000,
 				Language:   "uz-UZ",
 			},
@@ -127,13 +127,13 @@ func TestSampleRate(t *testing.T) {
 	type (
 		in struct {
 			sr      outputSampleRate
-			request request
+			request Request
 		}
 		testCase struct {
 			name   string
 			in     in
 			want   error
-			result request
+			result Request
 		}
 	)
 
@@ -142,28 +142,28 @@ func TestSampleRate(t *testing.T) {
 			name: "set 8kHz sample rate",
 			in: in{
 				sr:      OutputSampleRate8k,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{SampleRate: 8000},
+			result: Request{SampleRate: 8000},
 		},
 		{
 			name: "set 16kHz sample rate",
 			in: in{
 				sr:      OutputSampleRate16k,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{SampleRate: 16000},
+			result: Request{SampleRate: 16000},
 		},
 		{
 			name: "set 48kHz sample rate",
 			in: in{
 				sr:      OutputSampleRate48k,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{SampleRate: 48000},
+			result: Request{SampleRate: 48000},
 		},
 	}
 
@@ -180,14 +180,14 @@ func TestSampleRate(t *testing.T) {
 func TestVoice(t *testing.T) {
 	type (
 		in struct {
-			voice   voice
-			request request
+			voice   VoiceName
+			request Request
 		}
 		testCase struct {
 			name   string
 			in     in
 			want   error
-			result request
+			result Request
 		}
 	)
 
@@ -196,109 +196,109 @@ func TestVoice(t *testing.T) {
 			name: "set jana voice",
 			in: in{
 				voice:   VoiceJane,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "jane"},
+			result: Request{Voice: "jane"},
 		},
 		{
 			name: "set omazh voice",
 			in: in{
 				voice:   VoiceOmazh,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "omazh"},
+			result: Request{Voice: "omazh"},
 		},
 		{
 			name: "set zahar voice",
 			in: in{
 				voice:   VoiceZahar,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "zahar"},
+			result: Request{Voice: "zahar"},
 		},
 		{
 			name: "set ermil voice",
 			in: in{
 				voice:   VoiceErmil,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "ermil"},
+			result: Request{Voice: "ermil"},
 		},
 		{
 			name: "set alena voice",
 			in: in{
 				voice:   VoiceAlena,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "alena"},
+			result: Request{Voice: "alena"},
 		},
 		{
 			name: "set filipp voice",
 			in: in{
 				voice:   VoiceFilipp,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "filipp"},
+			result: Request{Voice: "filipp"},
 		},
 		{
 			name: "set Amira voice",
 			in: in{
 				voice:   VoiceAmira,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "amira"},
+			result: Request{Voice: "amira"},
 		},
 		{
 			name: "set Madi voice",
 			in: in{
 				voice:   VoiceMadi,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "madi"},
+			result: Request{Voice: "madi"},
 		},
 		{
 			name: "set MadiRus voice",
 			in: in{
 				voice:   VoiceMadiRus,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "madirus"},
+			result: Request{Voice: "madirus"},
 		},
 		{
 			name: "set Nigora voice",
 			in: in{
 				voice:   VoiceNigora,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "nigora"},
+			result: Request{Voice: "nigora"},
 		},
 		{
 			name: "set Lea voice",
 			in: in{
 				voice:   VoiceLea,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "lea"},
+			result: Request{Voice: "lea"},
 		},
 		{
 			name: "set John voice",
 			in: in{
 				voice:   VoiceJohn,
-				request: request{},
+				request: Request{},
 			},
 			want:   nil,
-			result: request{Voice: "john"},
+			result: Request{Voice: "john"},
 		},
 	}
 
@@ -339,7 +339,7 @@ func TestEmotion(t *testing.T) {
 
 	for _, emotion := range tests {
 		t.Run(emotion.name, func(t *testing.T) {
-			req := request{}
+			req := Request{}
 			_ = Emotion(emotion.in)(&req)
 			assert.Equal(t, emotion.want, req.Emotion)
 		})
@@ -350,13 +350,13 @@ func TestSpeed(t *testing.T) {
 	type (
 		in struct {
 			speed   float64
-			request request
+			request Request
 		}
 		testCase struct {
 			name   string
 			in     in
 			want   error
-			result request
+			result Request
 		}
 	)
 
@@ -365,12 +365,12 @@ func TestSpeed(t *testing.T) {
 			name: "check with speed < 0.1",
 			in: in{
 				speed: 0.01,
-				request: request{
+				request: Request{
 					Text: "123",
 				},
 			},
 			want: ErrInvalidSpeakingSpeed,
-			result: request{
+			result: Request{
 				Text: "123",
 			},
 		},
@@ -378,12 +378,12 @@ func TestSpeed(t *testing.T) {
 			name: "check with speed > 3",
 			in: in{
 				speed: 3.1,
-				request: request{
+				request: Request{
 					Text: "123",
 				},
 			},
 			want: ErrInvalidSpeakingSpeed,
-			result: request{
+			result: Request{
 				Text: "123",
 			},
 		},
@@ -391,11 +391,11 @@ func TestSpeed(t *testing.T) {
 			name: "check with normal speed",
 			in: in{
 				speed: 1,
-				request: request{
+				request: Request{
 					Text: "123",
 				},
 			},
-			result: request{
+			result: Request{
 				Text:  "123",
 				Speed: 1,
 			},
@@ -414,13 +414,13 @@ func TestSpeed(t *testing.T) {
 
 func TestFolderID(t *testing.T) {
 	t.Run("test with empty folderID", func(t *testing.T) {
-		req := request{}
+		req := Request{}
 		_ = FolderID("")(&req)
 
 		assert.Equal(t, req.FolderID, "")
 	})
 	t.Run("test with non empty folderID", func(t *testing.T) {
-		req := request{}
+		req := Request{}
 		_ = FolderID("123123")(&req)
 
 		assert.Equal(t, req.FolderID, "123123")
@@ -429,13 +429,13 @@ func TestFolderID(t *testing.T) {
 
 func TestOutputFormat(t *testing.T) {
 	t.Run("set LPCM", func(t *testing.T) {
-		req := request{}
+		req := Request{}
 		_ = OutputFormat(OutputFormatLPCM)(&req)
 
 		assert.Equal(t, req.OutputFormat, "lpcm")
 	})
 	t.Run("set oggopus", func(t *testing.T) {
-		req := request{}
+		req := Request{}
 		_ = OutputFormat(OutputFormatOggOpus)(&req)
 
 		assert.Equal(t, req.OutputFormat, "oggopus")
@@ -0,0 +1,151 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const testPresets = `
+ivr-ru:
+  voice: alena
+  lang: ru-RU
+  emotion: good
+  format: lpcm
+  sample_rate: 8000
+  speed: 1.1
+ivr-ru-male:
+  extends: ivr-ru
+  voice: zahar
+ivr-ru-slow:
+  extends: ivr-ru-male
+  speed: 0.8
+`
+
+func TestReadPresets(t *testing.T) {
+	presets, err := ReadPresets(strings.NewReader(testPresets))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ivr-ru", "ivr-ru-male", "ivr-ru-slow"}, presets.Names())
+
+	preset, err := presets.Preset("ivr-ru-slow")
+	assert.NoError(t, err)
+	assert.Equal(t, Preset{
+		Name:         "ivr-ru-slow",
+		Extends:      "ivr-ru-male",
+		Voice:        "zahar",
+		Language:     "ru-RU",
+		Emotion:      "good",
+		Speed:        0.8,
+		OutputFormat: "lpcm",
+		SampleRate:   8000,
+	}, preset)
+
+	options, err := presets.Options("ivr-ru-male")
+	assert.NoError(t, err)
+
+	r := NewRequest()
+
+	for _, option := range options {
+		assert.NoError(t, option(r))
+	}
+
+	assert.Equal(t, Request{
+		Voice:        "zahar",
+		Language:     "ru-RU",
+		Emotion:      "good",
+		Speed:        1.1,
+		OutputFormat: "lpcm",
+		SampleRate:   8000,
+	}, *r)
+
+	_, err = presets.Options("missing")
+	assert.ErrorIs(t, err, ErrUnknownPreset)
+}
+
+func TestReadPresets_JSON(t *testing.T) {
+	presets, err := ReadPresets(strings.NewReader(`{"en": {"voice": "john", "lang": "en-US"}}`))
+
+	assert.NoError(t, err)
+
+	preset, err := presets.Preset("en")
+	assert.NoError(t, err)
+	assert.Equal(t, "john", preset.Voice)
+}
+
+func TestNewPresets_Invalid(t *testing.T) {
+	_, err := NewPresets(
+		Preset{Name: "base", Voice: "alena", Emotion: "evil"},
+		Preset{Name: "child", Extends: "base", Voice: "jane", SampleRate: 22050},
+		Preset{Name: "english", Extends: "base", Language: "en-US", Speed: 5},
+		Preset{Name: "orphan", Extends: "missing"},
+		Preset{Name: "a", Extends: "b"},
+		Preset{Name: "b", Extends: "a"},
+	)
+
+	var problems ValidationError
+
+	assert.True(t, errors.As(err, &problems))
+
+	fields := make([]string, 0, len(problems))
+
+	for _, problem := range problems {
+		var presetErr *PresetError
+
+		assert.True(t, errors.As(problem, &presetErr))
+		fields = append(fields, presetErr.Preset+"."+presetErr.Field)
+	}
+
+	assert.Equal(t, []string{
+		"a.extends", "b.extends", "base.emotion", "child.sample_rate",
+		"english.lang", "english.emotion", "english.speed", "orphan.extends",
+	}, fields)
+	assert.ErrorIs(t, err, ErrPresetCycle)
+	assert.ErrorIs(t, err, ErrUnknownPreset)
+	assert.Contains(t, err.Error(), `preset "child": sample_rate: invalid sample rate 22050`)
+}
+
+func TestNewPresets_Duplicate(t *testing.T) {
+	_, err := NewPresets(Preset{Name: "ivr", Voice: "alena"}, Preset{Name: "ivr", Voice: "jane"})
+
+	assert.ErrorIs(t, err, ErrDuplicatePreset)
+	assert.EqualError(t, err, `preset "ivr": name: duplicate preset`)
+
+	_, err = NewPresets(
+		Preset{Voice: "alena"},
+		Preset{Name: "ivr", Voice: "alena"},
+		Preset{Name: "ivr", Voice: "jane"},
+		Preset{Name: "fast", Speed: 5},
+	)
+
+	var problems ValidationError
+
+	assert.True(t, errors.As(err, &problems))
+	assert.Len(t, problems, 3)
+	assert.ErrorIs(t, err, ErrEmptyPresetName)
+	assert.ErrorIs(t, err, ErrDuplicatePreset)
+	assert.ErrorIs(t, err, ErrInvalidSpeakingSpeed)
+	assert.NotErrorIs(t, err, ErrUnknownPreset)
+}
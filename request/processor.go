@@ -0,0 +1,312 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type (
+	// TextProcessor transforms text before it is sent to the TTS service
+	TextProcessor interface {
+		ProcessText(text string) (string, error)
+	}
+
+	// TextProcessorFunc is an adapter to allow the use of ordinary functions as TextProcessor
+	TextProcessorFunc func(text string) (string, error)
+
+	// WhitespaceProcessor collapses runs of whitespace into a single space and trims the text
+	WhitespaceProcessor struct{}
+	// EmojiProcessor removes emoji and pictographic symbols from the text, the text symbols
+	// such as arrows, check marks and dingbats are kept unless followed by the emoji variation selector
+	EmojiProcessor struct{}
+	// URLProcessor removes http(s), ftp and www links from the text
+	URLProcessor struct{}
+)
+
+const (
+	// emojiVariation is the variation selector requesting the emoji presentation of the preceding symbol
+	emojiVariation  = '\ufe0f'
+	zeroWidthJoiner = '\u200d'
+)
+
+var (
+	urlRegexp = regexp.MustCompile(`(?i)\b(?:https?://|ftp://|www\.)[^\s<>"]*[^\s<>".,;:!?'»)\]}]`)
+
+	// emojiTable holds the symbols with the emoji presentation by default and the pictographic
+	// emoji blocks, the other symbols are emoji only when followed by the emoji variation selector
+	emojiTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+			{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+			{Lo: 0x23f0, Hi: 0x23f3, Stride: 3},
+			{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+			{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+			{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+			{Lo: 0x267f, Hi: 0x2693, Stride: 20},
+			{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+			{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+			{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+			{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+			{Lo: 0x26ce, Hi: 0x26d4, Stride: 6},
+			{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+			{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+			{Lo: 0x26f5, Hi: 0x26fa, Stride: 5},
+			{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+			{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+			{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+			{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+			{Lo: 0x274c, Hi: 0x274e, Stride: 2},
+			{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+			{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+			{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+			{Lo: 0x27b0, Hi: 0x27bf, Stride: 15},
+			{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+			{Lo: 0x2b50, Hi: 0x2b55, Stride: 5},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+			{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+			{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+			{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+			{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+			{Lo: 0x1f201, Hi: 0x1f201, Stride: 1},
+			{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+			{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+			{Lo: 0x1f232, Hi: 0x1f236, Stride: 1},
+			{Lo: 0x1f238, Hi: 0x1f23a, Stride: 1},
+			{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+			{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+			{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+			{Lo: 0x1f7e0, Hi: 0x1f7ff, Stride: 1},
+			{Lo: 0x1f900, Hi: 0x1f9ff, Stride: 1},
+			{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1},
+		},
+	}
+	// emojiModifiers are the invisible parts of the emoji sequences: the variation selectors,
+	// the combining keycap and the tags of the subdivision flags
+	emojiModifiers = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x20e3, Hi: 0x20e3, Stride: 1},
+			{Lo: 0xfe0e, Hi: 0xfe0f, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
+		},
+	}
+
+	xmlTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+)
+
+// ProcessText calls f(text)
+func (f TextProcessorFunc) ProcessText(text string) (string, error) {
+	return f(text)
+}
+
+// TextProcessors registers an ordered chain of text processors.
+// Processors registered on the client run before the ones passed to a single call.
+func TextProcessors(processors ...TextProcessor) Option {
+	return func(req *Request) error {
+		req.Processors = append(req.Processors, processors...)
+
+		return nil
+	}
+}
+
+// processText runs the text through the registered processors chain
+func (r Request) processText(text string) (string, error) {
+	var err error
+
+	for _, processor := range r.Processors {
+		if text, err = processor.ProcessText(text); err != nil {
+			return "", err
+		}
+	}
+
+	return text, nil
+}
+
+// processSSML runs every text node of the SSML document through the registered
+// processors chain and lexicons, leaving tags, attributes, comments and CDATA sections untouched
+func (r Request) processSSML(ssml string) (string, error) {
+	if len(r.Processors) == 0 && len(r.Lexicons) == 0 {
+		return ssml, nil
+	}
+
+	var (
+		result strings.Builder
+		// depth of <sub> and <phoneme> elements, their content is not looked up in lexicons
+		depth int
+	)
+
+	for ssml != "" {
+		text := ssml
+
+		if i := strings.IndexByte(ssml, '<'); i >= 0 {
+			text = ssml[:i]
+		}
+
+		if text != "" {
+			processed, err := r.processSSMLText(text, depth == 0)
+
+			if err != nil {
+				return "", err
+			}
+
+			result.WriteString(processed)
+		}
+
+		ssml = ssml[len(text):]
+		end := markupEnd(ssml)
+		markup := ssml[:end]
+		ssml = ssml[end:]
+
+		switch name := markupName(markup); {
+		case name != "sub" && name != "phoneme", strings.HasSuffix(markup, "/>"):
+		case strings.HasPrefix(markup, "</"):
+			depth--
+		default:
+			depth++
+		}
+
+		result.WriteString(markup)
+	}
+
+	return result.String(), nil
+}
+
+// processSSMLText processes the escaped text node and returns it escaped back
+func (r Request) processSSMLText(text string, lookup bool) (string, error) {
+	processed, err := r.processText(html.UnescapeString(text))
+
+	if err != nil {
+		return "", err
+	}
+
+	if lookup {
+		processed = renderLexicon(processed, matchLexicons(r.Lexicons, processed), true)
+	} else {
+		processed = xmlTextEscaper.Replace(processed)
+	}
+
+	return keepSurroundingSpace(text, processed), nil
+}
+
+// markupName returns the element name of the start or end tag
+func markupName(markup string) string {
+	name := strings.TrimLeft(markup, "</")
+
+	if i := strings.IndexFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '/' || r == '>'
+	}); i >= 0 {
+		name = name[:i]
+	}
+
+	return name
+}
+
+// markupEnd returns the length of the markup at the beginning of s
+func markupEnd(s string) int {
+	var terminator string
+
+	switch {
+	case s == "":
+		return 0
+	case strings.HasPrefix(s, "<!--"):
+		terminator = "-->"
+	case strings.HasPrefix(s, "<![CDATA["):
+		terminator = "]]>"
+	case strings.HasPrefix(s, "<?"):
+		terminator = "?>"
+	default:
+		var quote rune
+
+		for i, c := range s {
+			switch {
+			case quote != 0:
+				if c == quote {
+					quote = 0
+				}
+			case c == '"' || c == '\'':
+				quote = c
+			case c == '>':
+				return i + 1
+			}
+		}
+
+		return len(s)
+	}
+
+	if i := strings.Index(s, terminator); i >= 0 {
+		return i + len(terminator)
+	}
+
+	return len(s)
+}
+
+// keepSurroundingSpace restores a separating space around the processed text node,
+// so that words from neighbouring nodes are not glued together
+func keepSurroundingSpace(original, processed string) string {
+	if strings.TrimLeftFunc(original, unicode.IsSpace) != original && !strings.HasPrefix(processed, " ") {
+		processed = " " + processed
+	}
+
+	if strings.TrimRightFunc(original, unicode.IsSpace) != original && !strings.HasSuffix(processed, " ") {
+		processed += " "
+	}
+
+	return processed
+}
+
+func (WhitespaceProcessor) ProcessText(text string) (string, error) {
+	return collapseSpaces(text), nil
+}
+
+func (EmojiProcessor) ProcessText(text string) (string, error) {
+	var (
+		result  strings.Builder
+		runes   = []rune(text)
+		removed bool
+	)
+
+	for i, r := range runes {
+		presented := i+1 < len(runes) && runes[i+1] == emojiVariation && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+
+		switch {
+		case unicode.Is(emojiTable, r), presented:
+			removed = true
+		case unicode.Is(emojiModifiers, r), r == zeroWidthJoiner && removed:
+		default:
+			removed = false
+			result.WriteRune(r)
+		}
+	}
+
+	return result.String(), nil
+}
+
+func (URLProcessor) ProcessText(text string) (string, error) {
+	return urlRegexp.ReplaceAllString(text, ""), nil
+}
@@ -0,0 +1,187 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestTextProcessors(t *testing.T) {
+	r := Request{}
+
+	assert.NoError(t, TextProcessors(WhitespaceProcessor{})(&r))
+	assert.NoError(t, TextProcessors(EmojiProcessor{}, URLProcessor{})(&r))
+	assert.Len(t, r.Processors, 3)
+}
+
+func TestBuiltinProcessors(t *testing.T) {
+	type testCase struct {
+		name      string
+		processor TextProcessor
+		in        string
+		out       string
+	}
+
+	tests := []testCase{
+		{
+			name:      "collapse whitespace",
+			processor: WhitespaceProcessor{},
+			in:        "  привет \n\t мир  ",
+			out:       "привет мир",
+		},
+		{
+			name:      "remove emoji",
+			processor: EmojiProcessor{},
+			in:        "hello 👋🏽 world ❤️ 👨‍👩‍👧",
+			out:       "hello  world  ",
+		},
+		{
+			name:      "keep regular symbols",
+			processor: EmojiProcessor{},
+			in:        "«цена» — 100 ₽",
+			out:       "«цена» — 100 ₽",
+		},
+		{
+			name:      "keep text symbols",
+			processor: EmojiProcessor{},
+			in:        "⌀ 5 мм ☎ ✓ готово ➔ далее ★",
+			out:       "⌀ 5 мм ☎ ✓ готово ➔ далее ★",
+		},
+		{
+			name:      "remove symbols with emoji presentation",
+			processor: EmojiProcessor{},
+			in:        "звоните ☎️ ✅ готово ©️",
+			out:       "звоните   готово ",
+		},
+		{
+			name:      "remove urls",
+			processor: URLProcessor{},
+			in:        "docs: https://cloud.yandex.ru/docs/speechkit?lang=ru, www.example.com.",
+			out:       "docs: , .",
+		},
+		{
+			name:      "remove url in brackets",
+			processor: URLProcessor{},
+			in:        "see (http://example.com/a_b)",
+			out:       "see ()",
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			got, err := entry.processor.ProcessText(entry.in)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entry.out, got)
+		})
+	}
+}
+
+func TestRequest_processText(t *testing.T) {
+	t.Run("processors are applied in order", func(t *testing.T) {
+		r := Request{
+			Processors: []TextProcessor{
+				URLProcessor{},
+				EmojiProcessor{},
+				WhitespaceProcessor{},
+				TextProcessorFunc(func(text string) (string, error) {
+					return strings.ToUpper(text), nil
+				}),
+			},
+		}
+
+		got, err := r.processText(" go to 👉 https://ya.ru now ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "GO TO NOW", got)
+	})
+	t.Run("error stops the chain", func(t *testing.T) {
+		failure := errors.New("failure")
+		r := Request{
+			Processors: []TextProcessor{
+				TextProcessorFunc(func(text string) (string, error) {
+					return "", failure
+				}),
+				TextProcessorFunc(func(text string) (string, error) {
+					t.Fail()
+
+					return text, nil
+				}),
+			},
+		}
+
+		got, err := r.processText("foo")
+
+		assert.ErrorIs(t, err, failure)
+		assert.Equal(t, "", got)
+	})
+}
+
+func TestRequest_processSSML(t *testing.T) {
+	type testCase struct {
+		name string
+		in   string
+		out  string
+	}
+
+	r := Request{Processors: []TextProcessor{URLProcessor{}, EmojiProcessor{}, WhitespaceProcessor{}}}
+
+	tests := []testCase{
+		{
+			name: "markup is left untouched",
+			in:   `<speak>Привет 👋  <break time="2s"/>   мир</speak>`,
+			out:  `<speak>Привет <break time="2s"/> мир</speak>`,
+		},
+		{
+			name: "attributes containing urls and brackets",
+			in:   `<speak><audio src="https://ya.ru/a.wav" alt="a > b">see https://ya.ru</audio></speak>`,
+			out:  `<speak><audio src="https://ya.ru/a.wav" alt="a > b">see</audio></speak>`,
+		},
+		{
+			name: "entities are decoded and escaped back",
+			in:   `<speak>Tom &amp;   Jerry &lt;3</speak>`,
+			out:  `<speak>Tom &amp; Jerry &lt;3</speak>`,
+		},
+		{
+			name: "comments and cdata are left untouched",
+			in:   "<speak><!-- 👋  www.ya.ru --><![CDATA[ 👋 ]]>  hi </speak>",
+			out:  "<speak><!-- 👋  www.ya.ru --><![CDATA[ 👋 ]]> hi </speak>",
+		},
+		{
+			name: "words in neighbouring nodes are not glued",
+			in:   "<speak>say <emphasis> this </emphasis> twice</speak>",
+			out:  "<speak>say <emphasis> this </emphasis> twice</speak>",
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			got, err := r.processSSML(entry.in)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entry.out, got)
+		})
+	}
+}
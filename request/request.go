@@ -35,7 +35,8 @@ var (
 	ErrInvalidSpeakingSpeed = errors.New("invalid speaking speed")
 )
 
-type request struct {
+// Request is the effective request after the defaults, the options and the entity are applied
+type Request struct {
 	Text         string
 	SSML         string
 	Language     string
@@ -45,9 +46,24 @@ type request struct {
 	SampleRate   int
 	OutputFormat string
 	FolderID     string
+	Processors   []TextProcessor
+	Lexicons     []*Lexicon
+	// Resample allows the lpcm sample rates the API does not offer, it is not sent to the API
+	Resample bool
 }
 
-func (r request) Body() (io.Reader, error) {
+func (r Request) Body() (io.Reader, error) {
+	v, err := r.form()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.NewReader(v.Encode()), nil
+}
+
+// form returns the form values sent to the API
+func (r Request) form() (url.Values, error) {
 	v := url.Values{}
 
 	if r.Text != "" {
@@ -86,5 +102,5 @@ func (r request) Body() (io.Reader, error) {
 		v.Set("format", r.OutputFormat)
 	}
 
-	return strings.NewReader(v.Encode()), nil
+	return v, nil
 }
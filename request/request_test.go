@@ -28,12 +28,12 @@ import (
 )
 
 func TestNewRequest(t *testing.T) {
-	assert.Equal(t, *(NewRequest()), request{})
+	assert.Equal(t, *(NewRequest()), Request{})
 }
 
 func TestRequest_Body(t *testing.T) {
 	t.Run("with all fields", func(t *testing.T) {
-		r := request{
+		r := Request{
 			Text:         "text",
 			SSML:         "<speak>123</speak>",
 			Language:     "ru-RU",
@@ -55,14 +55,14 @@ func TestRequest_Body(t *testing.T) {
 		)
 	})
 	t.Run("without any fields", func(t *testing.T) {
-		r := request{}
+		r := Request{}
 
 		body, err := r.Body()
 		assert.ErrorIs(t, err, ErrNoSpeakEntity)
 		assert.Nil(t, body)
 	})
 	t.Run("with only ssml field", func(t *testing.T) {
-		r := request{
+		r := Request{
 			SSML: "<speak>123</speak>",
 		}
 
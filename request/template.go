@@ -0,0 +1,143 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"fmt"
+	"html"
+	"reflect"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+const templateEscaper = "_yattsEscape"
+
+// textEscaper removes the stress marks from the values of the plain text templates
+var textEscaper = strings.NewReplacer("+", "")
+
+// TemplateEntity renders the Go text/template with the data,
+// every value printed by the template is escaped for the target
+type TemplateEntity struct {
+	Template string
+	Data     interface{}
+	Funcs    template.FuncMap
+	// SSML renders the template as the SSML document with the XML escaped values,
+	// otherwise the template is rendered as plain text
+	SSML bool
+}
+
+func (e TemplateEntity) Process(req *Request) error {
+	if e.Template == "" {
+		return ErrEmptyTextEntry
+	}
+
+	text, err := e.render()
+
+	if err != nil {
+		return err
+	}
+
+	if e.SSML {
+		return SSMLTextEntity{SSML: text}.Process(req)
+	}
+
+	return SimpleTextEntity{Text: text}.Process(req)
+}
+
+func (e TemplateEntity) render() (string, error) {
+	escape := textEscaper.Replace
+
+	if e.SSML {
+		escape = html.EscapeString
+	}
+
+	t, err := template.New("entity").
+		Option("missingkey=error").
+		Funcs(e.Funcs).
+		Funcs(template.FuncMap{
+			templateEscaper: func(value interface{}) string {
+				return escape(templateValue(value))
+			},
+		}).
+		Parse(e.Template)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, tmpl := range t.Templates() {
+		if tmpl.Tree != nil {
+			escapeTemplateNode(tmpl.Tree.Root)
+		}
+	}
+
+	var result strings.Builder
+
+	if err := t.Execute(&result, e.Data); err != nil {
+		return "", err
+	}
+
+	return result.String(), nil
+}
+
+// templateValue prints the value, nil values are printed as empty text instead of "<nil>"
+func templateValue(value interface{}) string {
+	v := reflect.ValueOf(value)
+
+	if !v.IsValid() || (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return ""
+	}
+
+	return fmt.Sprint(value)
+}
+
+// escapeTemplateNode pipes every printed value of the template through the escaper
+func escapeTemplateNode(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+
+		for _, child := range n.Nodes {
+			escapeTemplateNode(child)
+		}
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) == 0 {
+			n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+				NodeType: parse.NodeCommand,
+				Pos:      n.Pos,
+				Args:     []parse.Node{parse.NewIdentifier(templateEscaper).SetTree(nil).SetPos(n.Pos)},
+			})
+		}
+	case *parse.IfNode:
+		escapeTemplateNode(n.List)
+		escapeTemplateNode(n.ElseList)
+	case *parse.RangeNode:
+		escapeTemplateNode(n.List)
+		escapeTemplateNode(n.ElseList)
+	case *parse.WithNode:
+		escapeTemplateNode(n.List)
+		escapeTemplateNode(n.ElseList)
+	}
+}
@@ -0,0 +1,147 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateEntity_Process(t *testing.T) {
+	type (
+		in struct {
+			entity TemplateEntity
+		}
+		testCase struct {
+			name     string
+			in       in
+			err      bool
+			expected Request
+		}
+	)
+
+	tests := []testCase{
+		{
+			name: "plain text",
+			in: in{entity: TemplateEntity{
+				Template: "Здравствуйте, {{.Name}}!",
+				Data:     map[string]string{"Name": "Tom & <Jerry>"},
+			}},
+			expected: Request{Text: "Здравствуйте, Tom & <Jerry>!"},
+		},
+		{
+			name: "stress marks in values are removed",
+			in: in{entity: TemplateEntity{
+				Template: "Язык {{.}}, з+амок",
+				Data:     "C++",
+			}},
+			expected: Request{Text: "Язык C, з+амок"},
+		},
+		{
+			name: "ssml values are escaped",
+			in: in{entity: TemplateEntity{
+				Template: `<speak>Здравствуйте, <sub alias="{{.Alias}}">{{.Name}}</sub>!` +
+					`{{range .Items}} {{.}}{{end}}{{if .Pause}}<break time="1s"/>{{end}}</speak>`,
+				Data: map[string]interface{}{
+					"Name":  "Tom & <Jerry>",
+					"Alias": `"quoted"`,
+					"Items": []string{"a<b", "c&d"},
+					"Pause": true,
+				},
+				SSML: true,
+			}},
+			expected: Request{SSML: `<speak>Здравствуйте, <sub alias="&#34;quoted&#34;">Tom &amp; &lt;Jerry&gt;</sub>!` +
+				` a&lt;b c&amp;d<break time="1s"/></speak>`},
+		},
+		{
+			name: "nested templates, variables and functions",
+			in: in{entity: TemplateEntity{
+				Template: `{{define "name"}}{{upper .}}{{end}}<speak>{{$name := .}}{{template "name" $name}}</speak>`,
+				Data:     "<b>",
+				Funcs:    template.FuncMap{"upper": strings.ToUpper},
+				SSML:     true,
+			}},
+			expected: Request{SSML: `<speak>&lt;B&gt;</speak>`},
+		},
+		{
+			name: "invalid ssml",
+			in: in{entity: TemplateEntity{
+				Template: `<foo>{{.}}</foo>`,
+				Data:     "bar",
+				SSML:     true,
+			}},
+			err: true,
+		},
+		{
+			name: "invalid template",
+			in:   in{entity: TemplateEntity{Template: `{{.Name`}},
+			err:  true,
+		},
+		{
+			name: "missing key",
+			in: in{entity: TemplateEntity{
+				Template: `{{.Name}}`,
+				Data:     map[string]string{},
+			}},
+			err: true,
+		},
+		{
+			name: "nil values are empty",
+			in: in{entity: TemplateEntity{
+				Template: `{{.Name}}, {{.Title}}!`,
+				Data: map[string]interface{}{
+					"Name":  nil,
+					"Title": (*string)(nil),
+				},
+			}},
+			expected: Request{Text: ", !"},
+		},
+		{
+			name: "empty template",
+			in:   in{entity: TemplateEntity{}},
+			err:  true,
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			r := Request{}
+			err := entry.in.entity.Process(&r)
+
+			if entry.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, entry.expected, r)
+		})
+	}
+
+	t.Run("invalid ssml error", func(t *testing.T) {
+		err := TemplateEntity{Template: `<foo>{{.}}</foo>`, SSML: true}.Process(&Request{})
+
+		assert.ErrorIs(t, err, ErrInvalidSSML)
+	})
+}
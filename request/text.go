@@ -33,7 +33,7 @@ var (
 
 type (
 	TextEntity interface {
-		Process(req *request) error
+		Process(req *Request) error
 	}
 
 	SimpleTextEntity struct {
@@ -48,18 +48,31 @@ type (
 	}
 )
 
-func (e SimpleTextEntity) Process(req *request) error {
+func (e SimpleTextEntity) Process(req *Request) error {
 	if e.Text == "" {
 		return ErrEmptyTextEntry
 	}
 
-	req.SSML = ""
-	req.Text = e.Text
+	text, err := req.processText(e.Text)
+
+	if err != nil {
+		return err
+	} else if text == "" {
+		return ErrEmptyTextEntry
+	}
+
+	if matches := matchLexicons(req.Lexicons, text); needsSSML(matches) {
+		req.Text = ""
+		req.SSML = "<speak>" + renderLexicon(text, matches, true) + "</speak>"
+	} else {
+		req.SSML = ""
+		req.Text = renderLexicon(text, matches, false)
+	}
 
 	return nil
 }
 
-func (e SSMLTextEntity) Process(req *request) error {
+func (e SSMLTextEntity) Process(req *Request) error {
 	var result ssmlValidationStruct
 
 	if e.SSML == "" {
@@ -68,8 +81,25 @@ func (e SSMLTextEntity) Process(req *request) error {
 		return ErrInvalidSSML
 	}
 
+	ssml, err := req.processSSML(e.SSML)
+
+	if err != nil {
+		return err
+	}
+
 	req.Text = ""
-	req.SSML = e.SSML
+	req.SSML = ssml
 
 	return nil
 }
+
+// needsSSML reports whether any of the lexicon matches can be spoken only through SSML
+func needsSSML(matches []lexiconMatch) bool {
+	for _, m := range matches {
+		if m.entry.ssml() {
+			return true
+		}
+	}
+
+	return false
+}
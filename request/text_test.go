@@ -30,13 +30,13 @@ func TestSimpleTextEntity_process(t *testing.T) {
 	type (
 		in struct {
 			text    string
-			request request
+			request Request
 		}
 		testCase struct {
 			name     string
 			in       in
 			out      error
-			expected request
+			expected Request
 		}
 	)
 
@@ -45,19 +45,19 @@ func TestSimpleTextEntity_process(t *testing.T) {
 			name: "check empty text",
 			in: in{
 				text:    "",
-				request: request{},
+				request: Request{},
 			},
 			out:      ErrEmptyTextEntry,
-			expected: request{},
+			expected: Request{},
 		},
 		{
 			name: "check non empty text",
 			in: in{
 				text:    "123",
-				request: request{},
+				request: Request{},
 			},
 			out: nil,
-			expected: request{
+			expected: Request{
 				Text: "123",
 			},
 		},
@@ -65,15 +65,42 @@ func TestSimpleTextEntity_process(t *testing.T) {
 			name: "check truncate ssml",
 			in: in{
 				text: "bazz",
-				request: request{
+				request: Request{
 					SSML: "foo",
 				},
 			},
 			out: nil,
-			expected: request{
+			expected: Request{
 				Text: "bazz",
 			},
 		},
+		{
+			name: "check processed text",
+			in: in{
+				text: " bazz  👋 ",
+				request: Request{
+					Processors: []TextProcessor{EmojiProcessor{}, WhitespaceProcessor{}},
+				},
+			},
+			out: nil,
+			expected: Request{
+				Text:       "bazz",
+				Processors: []TextProcessor{EmojiProcessor{}, WhitespaceProcessor{}},
+			},
+		},
+		{
+			name: "check text emptied by processors",
+			in: in{
+				text: "https://ya.ru",
+				request: Request{
+					Processors: []TextProcessor{URLProcessor{}},
+				},
+			},
+			out: ErrEmptyTextEntry,
+			expected: Request{
+				Processors: []TextProcessor{URLProcessor{}},
+			},
+		},
 	}
 
 	for _, entry := range tests {
@@ -92,13 +119,13 @@ func TestSSMLTextEntity_process(t *testing.T) {
 	type (
 		in struct {
 			text    string
-			request request
+			request Request
 		}
 		testCase struct {
 			name     string
 			in       in
 			out      error
-			expected request
+			expected Request
 		}
 	)
 
@@ -107,21 +134,21 @@ func TestSSMLTextEntity_process(t *testing.T) {
 			name: "check empty text",
 			in: in{
 				text:    "",
-				request: request{},
+				request: Request{},
 			},
 			out:      ErrEmptyTextEntry,
-			expected: request{},
+			expected: Request{},
 		},
 		{
 			name: "check invalid ssml",
 			in: in{
 				text: "laskdjf",
-				request: request{
+				request: Request{
 					Text: "foooo",
 				},
 			},
 			out: ErrInvalidSSML,
-			expected: request{
+			expected: Request{
 				Text: "foooo",
 			},
 		},
@@ -129,12 +156,12 @@ func TestSSMLTextEntity_process(t *testing.T) {
 			name: "check ssml with invalid root tag",
 			in: in{
 				text: "<foo>GMMN</foo>",
-				request: request{
+				request: Request{
 					Text: "foooo",
 				},
 			},
 			out: ErrInvalidSSML,
-			expected: request{
+			expected: Request{
 				Text: "foooo",
 			},
 		},
@@ -142,10 +169,10 @@ func TestSSMLTextEntity_process(t *testing.T) {
 			name: "check valid ssml",
 			in: in{
 				text:    "<speak>привет</speak>",
-				request: request{},
+				request: Request{},
 			},
 			out: nil,
-			expected: request{
+			expected: Request{
 				SSML: "<speak>привет</speak>",
 			},
 		},
@@ -153,15 +180,29 @@ func TestSSMLTextEntity_process(t *testing.T) {
 			name: "check truncate text",
 			in: in{
 				text: "<speak>привет</speak>",
-				request: request{
+				request: Request{
 					Text: "foo",
 				},
 			},
 			out: nil,
-			expected: request{
+			expected: Request{
 				SSML: "<speak>привет</speak>",
 			},
 		},
+		{
+			name: "check processed ssml",
+			in: in{
+				text: "<speak> привет  <break/> мир 👋</speak>",
+				request: Request{
+					Processors: []TextProcessor{EmojiProcessor{}, WhitespaceProcessor{}},
+				},
+			},
+			out: nil,
+			expected: Request{
+				SSML:       "<speak> привет <break/> мир</speak>",
+				Processors: []TextProcessor{EmojiProcessor{}, WhitespaceProcessor{}},
+			},
+		},
 	}
 
 	for _, entry := range tests {
@@ -0,0 +1,122 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnknownVoice        = errors.New("unknown voice")
+	ErrVoiceLanguage       = errors.New("voice does not speak the language")
+	ErrUnsupportedEmotion  = errors.New("emotion is not supported by the voice")
+	ErrInvalidOutputFormat = errors.New("invalid output format")
+	ErrInvalidSampleRate   = errors.New("invalid sample rate")
+)
+
+// ValidationError is the list of all problems found at once
+type ValidationError []error
+
+func (e ValidationError) Error() string {
+	messages := make([]string, 0, len(e))
+
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any of the problems matches the target
+func (e ValidationError) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// orNil returns nil when there are no problems
+func (e ValidationError) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Validate checks the combination of the options against the voice catalog,
+// all problems are returned at once as ValidationError
+func (r Request) Validate() error {
+	var problems ValidationError
+
+	if v := VoiceName(r.Voice); r.Voice != "" {
+		switch {
+		case !v.Known():
+			problems = append(problems, fmt.Errorf("%w %q", ErrUnknownVoice, r.Voice))
+		case r.Language != "" && !v.Speaks(lang(r.Language)):
+			problems = append(problems, fmt.Errorf("%w: %s does not speak %s", ErrVoiceLanguage, r.Voice, r.Language))
+		}
+
+		if v.Known() && !v.HasRole(emotion(r.Emotion)) {
+			problems = append(problems, fmt.Errorf("%w: %s has no %s emotion", ErrUnsupportedEmotion, r.Voice, r.Emotion))
+		}
+	}
+
+	switch outputFormat(r.OutputFormat) {
+	case OutputFormatLPCM:
+		switch outputSampleRate(r.SampleRate) {
+		case 0, OutputSampleRate8k, OutputSampleRate16k, OutputSampleRate48k:
+		default:
+			// the rates the API does not offer are resampled locally
+			if !r.Resample || r.SampleRate < 0 {
+				problems = append(problems, fmt.Errorf("%w %d for lpcm", ErrInvalidSampleRate, r.SampleRate))
+			}
+		}
+	case OutputFormatULaw, OutputFormatALaw:
+		if r.SampleRate != 0 && outputSampleRate(r.SampleRate) != OutputSampleRate8k {
+			problems = append(problems, fmt.Errorf("%w %d for %s", ErrInvalidSampleRate, r.SampleRate, r.OutputFormat))
+		}
+	case "", OutputFormatOggOpus:
+		// the sample rate is ignored by the oggopus default format
+	default:
+		problems = append(problems, fmt.Errorf("%w %q", ErrInvalidOutputFormat, r.OutputFormat))
+	}
+
+	return problems.orNil()
+}
+
+// OfferedSampleRate returns the lowest lpcm sample rate offered by the API that is not below the rate,
+// the highest offered rate is returned for the higher rates
+func OfferedSampleRate(rate int) int {
+	for _, offered := range []outputSampleRate{OutputSampleRate8k, OutputSampleRate16k, OutputSampleRate48k} {
+		if rate <= int(offered) {
+			return int(offered)
+		}
+	}
+
+	return int(OutputSampleRate48k)
+}
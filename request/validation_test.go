@@ -0,0 +1,107 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	type testCase struct {
+		name    string
+		request Request
+		errs    []error
+	}
+
+	tests := []testCase{
+		{
+			name:    "empty request uses the defaults",
+			request: Request{},
+		},
+		{
+			name: "valid lpcm request",
+			request: Request{
+				Voice: "jane", Language: "ru-RU", Emotion: "evil", OutputFormat: "lpcm", SampleRate: 8000,
+			},
+		},
+		{
+			name:    "oggopus ignores sample rate",
+			request: Request{Voice: "john", OutputFormat: "oggopus", SampleRate: 12345},
+		},
+		{
+			name:    "g711 at 8k",
+			request: Request{Voice: "alena", OutputFormat: "ulaw", SampleRate: 8000},
+		},
+		{
+			name:    "g711 at 16k",
+			request: Request{Voice: "alena", OutputFormat: "alaw", SampleRate: 16000},
+			errs:    []error{ErrInvalidSampleRate},
+		},
+		{
+			name: "all problems at once",
+			request: Request{
+				Voice: "john", Language: "ru-RU", Emotion: "good", OutputFormat: "lpcm", SampleRate: 22050,
+			},
+			errs: []error{ErrVoiceLanguage, ErrUnsupportedEmotion, ErrInvalidSampleRate},
+		},
+		{
+			name:    "unknown voice and format",
+			request: Request{Voice: "nobody", OutputFormat: "mp3"},
+			errs:    []error{ErrUnknownVoice, ErrInvalidOutputFormat},
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			err := entry.request.Validate()
+
+			if len(entry.errs) == 0 {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.IsType(t, ValidationError{}, err)
+			assert.Len(t, err, len(entry.errs))
+
+			for _, want := range entry.errs {
+				assert.ErrorIs(t, err, want)
+			}
+		})
+	}
+}
+
+func TestOfferedSampleRate(t *testing.T) {
+	tests := map[int]int{
+		8000:  8000,
+		11025: 16000,
+		16000: 16000,
+		22050: 48000,
+		44100: 48000,
+		96000: 48000,
+	}
+
+	for rate, expected := range tests {
+		assert.Equal(t, expected, OfferedSampleRate(rate), rate)
+	}
+}
@@ -0,0 +1,201 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"sort"
+)
+
+type (
+	gender     string
+	apiVersion int
+
+	voiceInfo struct {
+		languages []lang
+		gender    gender
+		roles     []emotion
+		versions  []apiVersion
+	}
+)
+
+const (
+	GenderFemale gender = "female"
+	GenderMale   gender = "male"
+
+	APIVersion1 apiVersion = 1
+	APIVersion3 apiVersion = 3
+)
+
+// voiceCatalog describes the voices available in the v1 API
+// https://cloud.yandex.ru/docs/speechkit/tts/voices
+var voiceCatalog = map[VoiceName]voiceInfo{
+	VoiceAlena: {
+		languages: []lang{LangRu},
+		gender:    GenderFemale,
+		roles:     []emotion{EmotionNeutral, EmotionGood},
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceFilipp: {
+		languages: []lang{LangRu},
+		gender:    GenderMale,
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceErmil: {
+		languages: []lang{LangRu},
+		gender:    GenderMale,
+		roles:     []emotion{EmotionNeutral, EmotionGood},
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceJane: {
+		languages: []lang{LangRu},
+		gender:    GenderFemale,
+		roles:     []emotion{EmotionNeutral, EmotionGood, EmotionEvil},
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceMadiRus: {
+		languages: []lang{LangRu},
+		gender:    GenderMale,
+		versions:  []apiVersion{APIVersion1},
+	},
+	VoiceOmazh: {
+		languages: []lang{LangRu},
+		gender:    GenderFemale,
+		roles:     []emotion{EmotionNeutral, EmotionEvil},
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceZahar: {
+		languages: []lang{LangRu},
+		gender:    GenderMale,
+		roles:     []emotion{EmotionNeutral, EmotionGood},
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceJohn: {
+		languages: []lang{LangEn},
+		gender:    GenderMale,
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceLea: {
+		languages: []lang{LangDE},
+		gender:    GenderFemale,
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceAmira: {
+		languages: []lang{LangKK},
+		gender:    GenderFemale,
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceMadi: {
+		languages: []lang{LangKK},
+		gender:    GenderMale,
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+	VoiceNigora: {
+		languages: []lang{LangUZ},
+		gender:    GenderFemale,
+		versions:  []apiVersion{APIVersion1, APIVersion3},
+	},
+}
+
+// Voices returns all voices of the catalog sorted by name
+func Voices() []VoiceName {
+	voices := make([]VoiceName, 0, len(voiceCatalog))
+
+	for v := range voiceCatalog {
+		voices = append(voices, v)
+	}
+
+	sort.Slice(voices, func(i, j int) bool { return voices[i] < voices[j] })
+
+	return voices
+}
+
+// VoicesFor returns the voices speaking the language sorted by name
+func VoicesFor(language lang) []VoiceName {
+	var voices []VoiceName
+
+	for _, v := range Voices() {
+		if v.Speaks(language) {
+			voices = append(voices, v)
+		}
+	}
+
+	return voices
+}
+
+// Known reports whether the voice is in the catalog
+func (v VoiceName) Known() bool {
+	_, ok := voiceCatalog[v]
+
+	return ok
+}
+
+// Languages returns the languages of the voice
+func (v VoiceName) Languages() []lang {
+	return append([]lang(nil), voiceCatalog[v].languages...)
+}
+
+// Speaks reports whether the voice speaks the language
+func (v VoiceName) Speaks(language lang) bool {
+	for _, l := range voiceCatalog[v].languages {
+		if l == language {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Gender returns the gender of the voice, empty for the unknown voice
+func (v VoiceName) Gender() gender {
+	return voiceCatalog[v].gender
+}
+
+// Roles returns the emotions supported by the voice, empty when the voice has the default role only
+func (v VoiceName) Roles() []emotion {
+	return append([]emotion(nil), voiceCatalog[v].roles...)
+}
+
+// HasRole reports whether the voice supports the emotion, EmotionNone is always supported
+func (v VoiceName) HasRole(role emotion) bool {
+	if role == EmotionNone {
+		return true
+	}
+
+	for _, r := range voiceCatalog[v].roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportedIn reports whether the voice is available in the API version
+func (v VoiceName) SupportedIn(version apiVersion) bool {
+	for _, ver := range voiceCatalog[v].versions {
+		if ver == version {
+			return true
+		}
+	}
+
+	return false
+}
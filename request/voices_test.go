@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestVoices(t *testing.T) {
+	voices := Voices()
+
+	assert.Len(t, voices, 12)
+	assert.Equal(t, VoiceAlena, voices[0])
+
+	for _, v := range voices {
+		assert.True(t, v.SupportedIn(APIVersion1), v)
+		assert.NotEmpty(t, v.Languages(), v)
+		assert.NotEmpty(t, v.Gender(), v)
+	}
+}
+
+func TestVoicesFor(t *testing.T) {
+	assert.Equal(t, []VoiceName{VoiceAmira, VoiceMadi}, VoicesFor(LangKK))
+	assert.Equal(t, []VoiceName{VoiceJohn}, VoicesFor(LangEn))
+	assert.Empty(t, VoicesFor("fr-FR"))
+}
+
+func TestVoiceMetadata(t *testing.T) {
+	type testCase struct {
+		name   string
+		voice  VoiceName
+		gender gender
+		roles  []emotion
+		v3     bool
+	}
+
+	tests := []testCase{
+		{name: "jane", voice: VoiceJane, gender: GenderFemale, roles: []emotion{EmotionNeutral, EmotionGood, EmotionEvil}, v3: true},
+		{name: "filipp", voice: VoiceFilipp, gender: GenderMale, v3: true},
+		{name: "madirus", voice: VoiceMadiRus, gender: GenderMale},
+		{name: "unknown", voice: "unknown"},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			assert.Equal(t, entry.gender != "", entry.voice.Known())
+			assert.Equal(t, entry.gender, entry.voice.Gender())
+			assert.Equal(t, entry.roles, entry.voice.Roles())
+			assert.Equal(t, entry.v3, entry.voice.SupportedIn(APIVersion3))
+			assert.True(t, entry.voice.HasRole(EmotionNone))
+		})
+	}
+
+	assert.True(t, VoiceOmazh.HasRole(EmotionEvil))
+	assert.False(t, VoiceZahar.HasRole(EmotionEvil))
+	assert.True(t, VoiceNigora.Speaks(LangUZ))
+}
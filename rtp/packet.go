@@ -0,0 +1,115 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package rtp sends the synthesized audio as the RTP stream paced in real time
+package rtp
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	ErrShortPacket    = errors.New("packet is shorter than the rtp header")
+	ErrInvalidVersion = errors.New("invalid rtp version")
+)
+
+const (
+	// HeaderSize is the size of the fixed header without the contributing sources
+	HeaderSize = 12
+
+	rtpVersion = 2
+)
+
+type (
+	// Header is the fixed RTP header, the contributing sources and the extensions are not used
+	Header struct {
+		Marker         bool
+		PayloadType    uint8
+		SequenceNumber uint16
+		Timestamp      uint32
+		SSRC           uint32
+	}
+
+	// Packet is the RTP packet of a single audio frame
+	Packet struct {
+		Header
+		Payload []byte
+	}
+)
+
+// Marshal returns the packet bytes sent over the network
+func (p Packet) Marshal() []byte {
+	result := make([]byte, HeaderSize+len(p.Payload))
+	result[0] = rtpVersion << 6
+	result[1] = p.PayloadType & 0x7f
+
+	if p.Marker {
+		result[1] |= 0x80
+	}
+
+	binary.BigEndian.PutUint16(result[2:], p.SequenceNumber)
+	binary.BigEndian.PutUint32(result[4:], p.Timestamp)
+	binary.BigEndian.PutUint32(result[8:], p.SSRC)
+	copy(result[HeaderSize:], p.Payload)
+
+	return result
+}
+
+// ParsePacket parses the packet, the contributing sources and the extension are skipped
+// and the padding is removed from the payload
+func ParsePacket(data []byte) (Packet, error) {
+	if len(data) < HeaderSize {
+		return Packet{}, ErrShortPacket
+	} else if data[0]>>6 != rtpVersion {
+		return Packet{}, ErrInvalidVersion
+	}
+
+	var (
+		packet = Packet{
+			Header: Header{
+				Marker:         data[1]&0x80 != 0,
+				PayloadType:    data[1] & 0x7f,
+				SequenceNumber: binary.BigEndian.Uint16(data[2:]),
+				Timestamp:      binary.BigEndian.Uint32(data[4:]),
+				SSRC:           binary.BigEndian.Uint32(data[8:]),
+			},
+		}
+		offset = HeaderSize + int(data[0]&0x0f)*4
+		end    = len(data)
+	)
+
+	if data[0]&0x10 != 0 && len(data) >= offset+4 {
+		offset += 4 + int(binary.BigEndian.Uint16(data[offset+2:]))*4
+	}
+
+	if data[0]&0x20 != 0 && end > 0 {
+		end -= int(data[end-1])
+	}
+
+	if offset > end {
+		return Packet{}, ErrShortPacket
+	}
+
+	packet.Payload = data[offset:end]
+
+	return packet, nil
+}
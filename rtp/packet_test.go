@@ -0,0 +1,79 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package rtp
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPacket_Marshal(t *testing.T) {
+	packet := Packet{
+		Header: Header{
+			Marker:         true,
+			PayloadType:    PayloadTypePCMA,
+			SequenceNumber: 0x1234,
+			Timestamp:      0xdeadbeef,
+			SSRC:           0x01020304,
+		},
+		Payload: []byte{1, 2, 3},
+	}
+	data := packet.Marshal()
+
+	assert.Equal(t, []byte{
+		0x80, 0x88, 0x12, 0x34, 0xde, 0xad, 0xbe, 0xef, 0x01, 0x02, 0x03, 0x04, 1, 2, 3,
+	}, data)
+
+	parsed, err := ParsePacket(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, packet, parsed)
+}
+
+func TestParsePacket(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		payload  []byte
+		expected error
+	}{
+		{
+			name:    "contributing sources, extension and padding",
+			data:    []byte{0xb1, 0x00, 0, 1, 0, 0, 0, 1, 0, 0, 0, 1, 9, 9, 9, 9, 0xbe, 0xde, 0, 1, 7, 7, 7, 7, 1, 2, 0, 2},
+			payload: []byte{1, 2},
+		},
+		{name: "short", data: []byte{0x80, 0}, expected: ErrShortPacket},
+		{name: "version", data: make([]byte, HeaderSize), expected: ErrInvalidVersion},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			packet, err := ParsePacket(entry.data)
+
+			assert.ErrorIs(t, err, entry.expected)
+
+			if entry.expected == nil {
+				assert.Equal(t, entry.payload, packet.Payload)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package rtp
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+var ErrInvalidFormat = errors.New("invalid rtp format")
+
+const (
+	// DefaultFrameDuration is the audio length of a single packet
+	DefaultFrameDuration = 20 * time.Millisecond
+
+	// PayloadTypePCMU and PayloadTypePCMA are the static payload types of the 8 kHz G.711
+	PayloadTypePCMU = 0
+	PayloadTypePCMA = 8
+	// PayloadTypeDynamic is the first dynamic payload type, the one of the L16 is negotiated by the call
+	PayloadTypeDynamic = 96
+)
+
+type (
+	// Format describes the samples of the stream and their payload type
+	Format struct {
+		PayloadType uint8
+		ClockRate   int
+		// SampleSize is 1 for the G.711 and 2 for the 16-bit lpcm sent in the network byte order
+		SampleSize int
+		// Silence is the sample byte the last frame is padded with
+		Silence byte
+	}
+
+	// Config is the state of the stream, the zero SSRC, sequence number and timestamp are random
+	Config struct {
+		SSRC           uint32
+		SequenceNumber uint16
+		Timestamp      uint32
+		// FrameDuration is DefaultFrameDuration when zero
+		FrameDuration time.Duration
+	}
+
+	// Packetizer splits the audio stream into the frames of the packets
+	Packetizer struct {
+		r         io.Reader
+		format    Format
+		header    Header
+		frame     time.Duration
+		frameSize int
+		err       error
+	}
+)
+
+// PCMU returns the format of the 8 kHz µ-law stream
+func PCMU() Format {
+	return Format{PayloadType: PayloadTypePCMU, ClockRate: 8000, SampleSize: 1, Silence: 0xff}
+}
+
+// PCMA returns the format of the 8 kHz A-law stream
+func PCMA() Format {
+	return Format{PayloadType: PayloadTypePCMA, ClockRate: 8000, SampleSize: 1, Silence: 0xd5}
+}
+
+// L16 returns the format of the 16-bit little-endian mono lpcm returned by the API,
+// the samples are swapped to the network byte order
+func L16(sampleRate int, payloadType uint8) Format {
+	return Format{PayloadType: payloadType, ClockRate: sampleRate, SampleSize: 2}
+}
+
+// NewPacketizer returns the packetizer of the stream read from r
+func NewPacketizer(r io.Reader, format Format, config Config) (*Packetizer, error) {
+	if format.ClockRate <= 0 || (format.SampleSize != 1 && format.SampleSize != 2) || format.PayloadType > 0x7f {
+		return nil, ErrInvalidFormat
+	}
+
+	if config.FrameDuration <= 0 {
+		config.FrameDuration = DefaultFrameDuration
+	}
+
+	samples := int(int64(config.FrameDuration) * int64(format.ClockRate) / int64(time.Second))
+
+	if samples == 0 {
+		return nil, ErrInvalidFormat
+	}
+
+	if config.SSRC == 0 {
+		config.SSRC = randomUint32()
+	}
+
+	if config.SequenceNumber == 0 {
+		config.SequenceNumber = uint16(randomUint32())
+	}
+
+	if config.Timestamp == 0 {
+		config.Timestamp = randomUint32()
+	}
+
+	return &Packetizer{
+		r:      r,
+		format: format,
+		header: Header{
+			// the marker starts the talkspurt
+			Marker:         true,
+			PayloadType:    format.PayloadType,
+			SequenceNumber: config.SequenceNumber,
+			Timestamp:      config.Timestamp,
+			SSRC:           config.SSRC,
+		},
+		frame:     config.FrameDuration,
+		frameSize: samples * format.SampleSize,
+	}, nil
+}
+
+// FrameDuration returns the audio length of a single packet
+func (p *Packetizer) FrameDuration() time.Duration {
+	return p.frame
+}
+
+// Next returns the packet of the next frame, the last frame is padded with the silence,
+// io.EOF is returned at the end of the stream
+func (p *Packetizer) Next() (Packet, error) {
+	if p.err != nil {
+		return Packet{}, p.err
+	}
+
+	payload := make([]byte, p.frameSize)
+	n, err := io.ReadFull(p.r, payload)
+
+	switch {
+	case err == io.EOF:
+		p.err = io.EOF
+
+		return Packet{}, io.EOF
+	case err == io.ErrUnexpectedEOF:
+		p.err = io.EOF
+
+		for i := n; i < len(payload); i++ {
+			payload[i] = p.format.Silence
+		}
+	case err != nil:
+		p.err = err
+
+		return Packet{}, err
+	}
+
+	if p.format.SampleSize == 2 {
+		for i := 0; i+1 < len(payload); i += 2 {
+			payload[i], payload[i+1] = payload[i+1], payload[i]
+		}
+	}
+
+	packet := Packet{Header: p.header, Payload: payload}
+	p.header.Marker = false
+	p.header.SequenceNumber++
+	p.header.Timestamp += uint32(p.frameSize / p.format.SampleSize)
+
+	return packet, nil
+}
+
+func randomUint32() uint32 {
+	b := make([]byte, 4)
+
+	if _, err := rand.Read(b); err != nil {
+		return uint32(time.Now().UnixNano())
+	}
+
+	return binary.BigEndian.Uint32(b)
+}
@@ -0,0 +1,90 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package rtp
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestPacketizer_Next(t *testing.T) {
+	p, err := NewPacketizer(bytes.NewReader(bytes.Repeat([]byte{1}, 400)), PCMU(), Config{
+		SSRC:           42,
+		SequenceNumber: 0xffff,
+		Timestamp:      1000,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 20*time.Millisecond, p.FrameDuration())
+
+	var packets []Packet
+
+	for {
+		packet, err := p.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		assert.NoError(t, err)
+		packets = append(packets, packet)
+	}
+
+	assert.Len(t, packets, 3)
+	assert.Equal(t, Header{Marker: true, PayloadType: 0, SequenceNumber: 0xffff, Timestamp: 1000, SSRC: 42}, packets[0].Header)
+	assert.Equal(t, Header{PayloadType: 0, SequenceNumber: 0, Timestamp: 1160, SSRC: 42}, packets[1].Header)
+	assert.Equal(t, uint32(1320), packets[2].Timestamp)
+	assert.Equal(t, append(bytes.Repeat([]byte{1}, 80), bytes.Repeat([]byte{0xff}, 80)...), packets[2].Payload)
+
+	_, err = p.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestPacketizer_L16(t *testing.T) {
+	p, err := NewPacketizer(bytes.NewReader([]byte{1, 2, 3, 4}), L16(16000, PayloadTypeDynamic), Config{
+		FrameDuration: 10 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	packet, err := p.Next()
+	assert.NoError(t, err)
+	assert.Len(t, packet.Payload, 320)
+	assert.Equal(t, []byte{2, 1, 4, 3, 0}, packet.Payload[:5])
+	assert.Equal(t, uint8(PayloadTypeDynamic), packet.PayloadType)
+	assert.NotZero(t, packet.SSRC)
+}
+
+func TestNewPacketizer(t *testing.T) {
+	for _, format := range []Format{
+		{ClockRate: 8000, SampleSize: 3},
+		{SampleSize: 1},
+		{ClockRate: 8000, SampleSize: 1, PayloadType: 200},
+	} {
+		_, err := NewPacketizer(bytes.NewReader(nil), format, Config{})
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+	}
+
+	_, err := NewPacketizer(bytes.NewReader(nil), PCMA(), Config{FrameDuration: time.Microsecond})
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
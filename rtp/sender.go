@@ -0,0 +1,76 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package rtp
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Send writes the packets of the packetizer to w paced in real time, every packet is sent
+// at its frame position from the start, returns the number of the sent packets.
+// The w is usually the connected UDP socket.
+func Send(ctx context.Context, w io.Writer, p *Packetizer) (int, error) {
+	var (
+		start = time.Now()
+		timer = time.NewTimer(0)
+		sent  int
+	)
+
+	defer timer.Stop()
+
+	for {
+		packet, err := p.Next()
+
+		if err == io.EOF {
+			return sent, nil
+		} else if err != nil {
+			return sent, err
+		}
+
+		if wait := time.Until(start.Add(time.Duration(sent) * p.FrameDuration())); wait > 0 {
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+
+			timer.Reset(wait)
+
+			select {
+			case <-ctx.Done():
+				return sent, ctx.Err()
+			case <-timer.C:
+			}
+		} else if err = ctx.Err(); err != nil {
+			return sent, err
+		}
+
+		if _, err = w.Write(packet.Marshal()); err != nil {
+			return sent, err
+		}
+
+		sent++
+	}
+}
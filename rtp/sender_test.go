@@ -0,0 +1,87 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package rtp
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestSend(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	defer func() { _ = listener.Close() }()
+
+	conn, err := net.Dial("udp", listener.LocalAddr().String())
+	assert.NoError(t, err)
+
+	defer func() { _ = conn.Close() }()
+
+	// 100ms of the µ-law audio
+	p, err := NewPacketizer(bytes.NewReader(bytes.Repeat([]byte{7}, 800)), PCMU(), Config{SSRC: 1, SequenceNumber: 10})
+	assert.NoError(t, err)
+
+	start := time.Now()
+	sent, err := Send(context.Background(), conn, p)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, sent)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(80*time.Millisecond))
+
+	buf := make([]byte, 1500)
+
+	for i := 0; i < sent; i++ {
+		assert.NoError(t, listener.SetReadDeadline(time.Now().Add(time.Second)))
+
+		n, _, err := listener.ReadFrom(buf)
+		assert.NoError(t, err)
+
+		packet, err := ParsePacket(buf[:n])
+		assert.NoError(t, err)
+		assert.Equal(t, uint16(10+i), packet.SequenceNumber)
+		assert.Equal(t, i == 0, packet.Marker)
+		assert.Equal(t, bytes.Repeat([]byte{7}, 160), packet.Payload)
+	}
+}
+
+func TestSend_Cancel(t *testing.T) {
+	var buf bytes.Buffer
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	p, err := NewPacketizer(bytes.NewReader(make([]byte, 8000)), PCMA(), Config{})
+	assert.NoError(t, err)
+
+	sent, err := Send(ctx, &buf, p)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	// the packets are paced, so only the first ones of the second of the audio are sent
+	assert.Greater(t, sent, 0)
+	assert.Less(t, sent, 10)
+	assert.Equal(t, sent*(HeaderSize+160), buf.Len())
+}
@@ -0,0 +1,94 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"bytes"
+	"context"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/audio/oggopus"
+	"github.com/lEx0/yatts/auth"
+	"github.com/lEx0/yatts/request"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWithTags(t *testing.T) {
+	dir, err := ioutil.TempDir("", "yatts")
+	assert.NoError(t, err)
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	var stream bytes.Buffer
+
+	for _, pages := range [][]*oggopus.Page{
+		oggopus.NewPages(oggopus.Head{Version: 1, Channels: 1, PreSkip: 312, InputSampleRate: 48000}.Marshal(), 1, 0, 0),
+		oggopus.NewPages(oggopus.Comments{Vendor: "test"}.Marshal(), 1, 1, 0),
+		oggopus.NewPages([]byte{31 << 3, 0}, 1, 2, 960),
+	} {
+		stream.Write(pages[0].Marshal())
+	}
+
+	path := filepath.Join(dir, "chapter.opus")
+	assert.NoError(t, ioutil.WriteFile(path, stream.Bytes(), 0600))
+	assert.NoError(t, WithTags(audio.Tags{Title: "Глава 1", Artist: "alena", Track: 1})(path))
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+
+	defer func() { _ = f.Close() }()
+
+	_, err = oggopus.ReadPage(f)
+	assert.NoError(t, err)
+
+	page, err := oggopus.ReadPage(f)
+	assert.NoError(t, err)
+
+	comments, err := oggopus.ParseComments(page.Data)
+	assert.NoError(t, err)
+	assert.Equal(t, oggopus.Comments{Vendor: "test", Fields: []string{"TITLE=Глава 1", "ARTIST=alena", "TRACKNUMBER=1"}}, comments)
+}
+
+func TestYaTTS_SaveFileWith(t *testing.T) {
+	server := newLPCMServer(t, 1600)
+	defer server.Close()
+
+	client := NewYaTTS(auth.NewAPITokenAuth("token"), server.Client())
+	client.SetTTSEndpointURL(server.URL)
+
+	dir, err := ioutil.TempDir("", "yatts")
+	assert.NoError(t, err)
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "speech.pcm")
+	err = client.SaveFileWith(
+		context.Background(), path, request.SimpleTextEntity{Text: "Привет"}, []FileOption{WithTags(audio.Tags{Title: "Привет"})},
+	)
+	assert.ErrorIs(t, err, ErrUntaggableFile)
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
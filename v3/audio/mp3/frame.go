@@ -0,0 +1,174 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package mp3 scans, measures and joins the MPEG audio streams without decoding them
+package mp3
+
+import (
+	"errors"
+)
+
+var ErrInvalidFrame = errors.New("invalid mpeg audio frame")
+
+const (
+	// FrameHeaderSize is the size of the frame header without the crc
+	FrameHeaderSize = 4
+
+	// ChannelModeMono is the channel mode of the single channel frames
+	ChannelModeMono = 3
+
+	versionMPEG25 = 0
+	versionMPEG2  = 2
+	versionMPEG1  = 3
+)
+
+var (
+	// bitrates are the bitrates in kbit/s indexed by [mpeg1][layer-1][index]
+	bitrates = [2][3][16]int{
+		{
+			{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+			{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+			{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		},
+		{
+			{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+			{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+			{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		},
+	}
+	// sampleRates are indexed by [version][index]
+	sampleRates = [4][3]int{
+		versionMPEG25: {11025, 12000, 8000},
+		versionMPEG2:  {22050, 24000, 16000},
+		versionMPEG1:  {44100, 48000, 32000},
+	}
+)
+
+type (
+	// FrameHeader is the parsed header of the MPEG audio frame
+	FrameHeader struct {
+		// Version is 0 for MPEG 2.5, 2 for MPEG 2 and 3 for MPEG 1
+		Version     byte
+		Layer       int
+		Bitrate     int
+		SampleRate  int
+		Padding     bool
+		Protected   bool
+		ChannelMode byte
+		// Samples is the number of the samples per channel of the frame
+		Samples int
+		// Size is the size of the whole frame including the header
+		Size int
+		raw  [FrameHeaderSize]byte
+	}
+
+	// Frame is the whole MPEG audio frame
+	Frame struct {
+		FrameHeader
+		Data []byte
+	}
+)
+
+// ParseFrameHeader parses the header at the start of the data
+func ParseFrameHeader(data []byte) (FrameHeader, error) {
+	if len(data) < FrameHeaderSize || data[0] != 0xff || data[1]&0xe0 != 0xe0 {
+		return FrameHeader{}, ErrInvalidFrame
+	}
+
+	var (
+		version      = data[1] >> 3 & 0x03
+		layer        = 4 - int(data[1]>>1&0x03)
+		bitrateIndex = int(data[2] >> 4)
+		rateIndex    = int(data[2] >> 2 & 0x03)
+		mpeg1        = 0
+	)
+
+	if version == 1 || layer == 4 || bitrateIndex == 0 || bitrateIndex == 15 || rateIndex == 3 {
+		return FrameHeader{}, ErrInvalidFrame
+	} else if version == versionMPEG1 {
+		mpeg1 = 1
+	}
+
+	header := FrameHeader{
+		Version:     version,
+		Layer:       layer,
+		Bitrate:     bitrates[mpeg1][layer-1][bitrateIndex] * 1000,
+		SampleRate:  sampleRates[version][rateIndex],
+		Padding:     data[2]&0x02 != 0,
+		Protected:   data[1]&0x01 == 0,
+		ChannelMode: data[3] >> 6,
+	}
+	copy(header.raw[:], data)
+	header.Samples, header.Size = header.frameSize(header.Bitrate)
+
+	if header.Size <= FrameHeaderSize {
+		return FrameHeader{}, ErrInvalidFrame
+	}
+
+	return header, nil
+}
+
+// Channels returns the number of the channels
+func (h FrameHeader) Channels() int {
+	if h.ChannelMode == ChannelModeMono {
+		return 1
+	}
+
+	return 2
+}
+
+// compatible reports whether the frames can follow each other in a single stream
+func (h FrameHeader) compatible(other FrameHeader) bool {
+	return h.Version == other.Version && h.Layer == other.Layer &&
+		h.SampleRate == other.SampleRate && h.Channels() == other.Channels()
+}
+
+// frameSize returns the samples and the size of the frame of the given bitrate
+func (h FrameHeader) frameSize(bitrate int) (samples, size int) {
+	padding := 0
+
+	if h.Padding {
+		padding = 1
+	}
+
+	switch {
+	case h.Layer == 1:
+		return 384, (12*bitrate/h.SampleRate + padding) * 4
+	case h.Layer == 2 || h.Version == versionMPEG1:
+		return 1152, 144*bitrate/h.SampleRate + padding
+	default:
+		return 576, 72*bitrate/h.SampleRate + padding
+	}
+}
+
+// sideInfoSize returns the size of the layer III side information following the header
+func (h FrameHeader) sideInfoSize() int {
+	switch {
+	case h.Version == versionMPEG1 && h.Channels() == 1:
+		return 17
+	case h.Version == versionMPEG1:
+		return 32
+	case h.Channels() == 1:
+		return 9
+	default:
+		return 17
+	}
+}
@@ -0,0 +1,173 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package mp3
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// testFrames returns the layer III frames of the given bitrate index at 44100 Hz, the audio bytes are set to fill
+func testFrames(count int, bitrateIndex byte, fill byte) []byte {
+	var result []byte
+
+	for i := 0; i < count; i++ {
+		header, _ := ParseFrameHeader([]byte{0xff, 0xfb, bitrateIndex << 4, 0xc0})
+		frame := bytes.Repeat([]byte{fill}, header.Size)
+		copy(frame, []byte{0xff, 0xfb, bitrateIndex << 4, 0xc0})
+		result = append(result, frame...)
+	}
+
+	return result
+}
+
+func TestParseFrameHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		header  FrameHeader
+		invalid bool
+	}{
+		{
+			name:   "mpeg1 layer III 128k",
+			data:   []byte{0xff, 0xfb, 0x90, 0x44},
+			header: FrameHeader{Version: versionMPEG1, Layer: 3, Bitrate: 128000, SampleRate: 44100, ChannelMode: 1, Samples: 1152, Size: 417},
+		},
+		{
+			name:   "mpeg2 layer III 48k padded mono",
+			data:   []byte{0xff, 0xf3, 0x62, 0xc0},
+			header: FrameHeader{Version: versionMPEG2, Layer: 3, Bitrate: 48000, SampleRate: 22050, Padding: true, ChannelMode: 3, Samples: 576, Size: 157},
+		},
+		{name: "no sync", data: []byte{0x00, 0xfb, 0x90, 0x44}, invalid: true},
+		{name: "free bitrate", data: []byte{0xff, 0xfb, 0x00, 0x44}, invalid: true},
+		{name: "reserved rate", data: []byte{0xff, 0xfb, 0x9c, 0x44}, invalid: true},
+		{name: "short", data: []byte{0xff, 0xfb}, invalid: true},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			header, err := ParseFrameHeader(entry.data)
+
+			if entry.invalid {
+				if err != ErrInvalidFrame {
+					t.Errorf("got %v", err)
+				}
+
+				return
+			} else if err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			header.raw = [FrameHeaderSize]byte{}
+
+			if header != entry.header {
+				t.Errorf("got %+v", header)
+			}
+		})
+	}
+}
+
+func TestScanner_Next(t *testing.T) {
+	var (
+		tag    = append([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 5}, "title"...)
+		frames = testFrames(3, 9, 0x55)
+		id3v1  = append([]byte("TAG"), make([]byte, id3v1Size-3)...)
+		data   = append(append(append(append(tag, "junk"...), frames...), id3v1...), frames[:100]...)
+	)
+
+	scanner := NewScanner(bytes.NewReader(data))
+	count := 0
+
+	for {
+		frame, err := scanner.Next()
+
+		if err != nil {
+			break
+		} else if frame.Size != 417 || !bytes.Equal(frame.Data, frames[:417]) {
+			t.Errorf("frame %d: got %+v", count, frame.FrameHeader)
+		}
+
+		count++
+	}
+
+	if count != 3 {
+		t.Errorf("got %d frames", count)
+	}
+
+	if !bytes.Equal(scanner.Tag(), tag) {
+		t.Errorf("got tag %q", scanner.Tag())
+	}
+
+	if scanner.Skipped != 4 {
+		t.Errorf("skipped %d", scanner.Skipped)
+	}
+}
+
+// failingReader fails after the data is read like a broken stream
+type failingReader struct {
+	data []byte
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
+
+func TestScanner_NextReadError(t *testing.T) {
+	broken := errors.New("stream is broken")
+	frames := testFrames(3, 9, 0x55)
+
+	// the failure in the middle of the frame, of the header and of the ID3v1 tag
+	for _, data := range [][]byte{frames[:1000], frames[:834+2], append(append([]byte(nil), frames...), "TAG"...)} {
+		scanner := NewScanner(&failingReader{data: data, err: broken})
+
+		for {
+			_, err := scanner.Next()
+
+			if err == io.EOF {
+				t.Errorf("%d bytes: the read error must not end the stream", len(data))
+			}
+
+			if err != nil {
+				if err != io.EOF && !errors.Is(err, broken) {
+					t.Errorf("%d bytes: got %v", len(data), err)
+				}
+
+				break
+			}
+		}
+	}
+
+	if _, err := Probe(&failingReader{data: frames, err: broken}); !errors.Is(err, broken) {
+		t.Errorf("probe must return the read error, got %v", err)
+	}
+}
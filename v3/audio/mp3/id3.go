@@ -0,0 +1,170 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package mp3
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/lEx0/yatts/audio"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"time"
+)
+
+const (
+	id3v2Version = 4
+	// id3TextUTF8 is the text encoding byte of the UTF-8 text frames
+	id3TextUTF8 = 3
+	// id3NoOffset is written as the byte offsets of the chapters, the times are used instead
+	id3NoOffset = 0xffffffff
+	// ctocTopLevelOrdered are the flags of the top-level table of contents of the ordered chapters
+	ctocTopLevelOrdered = 0x03
+	// ctocMaxEntries is the limit of the one byte entry count of the table of contents
+	ctocMaxEntries = 255
+)
+
+// NewID3Tag returns the ID3v2.4 tag of the tags, the chapters are written as the CHAP frames listed
+// by the top-level CTOC frame and the zero ends of the chapters are taken from the duration
+func NewID3Tag(tags audio.Tags, duration time.Duration) []byte {
+	var (
+		frames bytes.Buffer
+		track  string
+	)
+
+	if tags.Track > 0 {
+		track = strconv.Itoa(tags.Track)
+
+		if tags.TrackTotal > 0 {
+			track += "/" + strconv.Itoa(tags.TrackTotal)
+		}
+	}
+
+	for _, text := range [][2]string{
+		{"TIT2", tags.Title},
+		{"TPE1", tags.Artist},
+		{"TALB", tags.Album},
+		{"TRCK", track},
+	} {
+		if text[1] != "" {
+			frames.Write(id3TextFrame(text[0], text[1]))
+		}
+	}
+
+	if len(tags.Chapters) > 0 {
+		var (
+			chapters = tags.ChapterEnds(duration)
+			entries  = len(chapters)
+		)
+
+		if entries > ctocMaxEntries {
+			entries = ctocMaxEntries
+		}
+
+		toc := append([]byte("toc\x00"), ctocTopLevelOrdered, byte(entries))
+
+		for i, chapter := range chapters {
+			id := fmt.Sprintf("chp%d\x00", i+1)
+			body := make([]byte, len(id)+16)
+
+			copy(body, id)
+			binary.BigEndian.PutUint32(body[len(id):], uint32(chapter.Start/time.Millisecond))
+			binary.BigEndian.PutUint32(body[len(id)+4:], uint32(chapter.End/time.Millisecond))
+			binary.BigEndian.PutUint32(body[len(id)+8:], id3NoOffset)
+			binary.BigEndian.PutUint32(body[len(id)+12:], id3NoOffset)
+
+			if chapter.Title != "" {
+				body = append(body, id3TextFrame("TIT2", chapter.Title)...)
+			}
+
+			if i < entries {
+				toc = append(toc, id...)
+			}
+
+			frames.Write(id3Frame("CHAP", body))
+		}
+
+		frames.Write(id3Frame("CTOC", toc))
+	}
+
+	header := make([]byte, id3v2HeaderSize)
+	copy(header, "ID3")
+	header[3] = id3v2Version
+	putSynchsafe(header[6:], frames.Len())
+
+	return append(header, frames.Bytes()...)
+}
+
+// SetTags copies the stream from r to w with the ID3v2.4 tag of the tags replacing the leading ID3v2 tags,
+// the zero ends of the chapters are taken from the duration of the stream
+func SetTags(w io.Writer, r io.Reader, tags audio.Tags) error {
+	data, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return err
+	}
+
+	for len(data) >= id3v2HeaderSize && string(data[:3]) == "ID3" {
+		if size := id3v2TagSize(data); size < len(data) {
+			data = data[size:]
+		} else {
+			return ErrNoFrames
+		}
+	}
+
+	info, err := Probe(bytes.NewReader(data))
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = w.Write(NewID3Tag(tags, info.Duration)); err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+
+	return err
+}
+
+// id3TextFrame returns the UTF-8 text frame
+func id3TextFrame(id, text string) []byte {
+	return id3Frame(id, append([]byte{id3TextUTF8}, text...))
+}
+
+// id3Frame returns the ID3v2.4 frame of the body
+func id3Frame(id string, body []byte) []byte {
+	frame := make([]byte, 10, 10+len(body))
+
+	copy(frame, id)
+	putSynchsafe(frame[4:], len(body))
+
+	return append(frame, body...)
+}
+
+// putSynchsafe writes the 28-bit synchsafe integer of the ID3v2 sizes
+func putSynchsafe(data []byte, value int) {
+	for i := 0; i < 4; i++ {
+		data[i] = byte(value>>(7*(3-i))) & 0x7f
+	}
+}
@@ -0,0 +1,105 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package mp3
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/lEx0/yatts/audio"
+	"testing"
+	"time"
+)
+
+// id3Frames returns the frames of the tag by their ids
+func id3Frames(t *testing.T, tag []byte) map[string][]byte {
+	if string(tag[:4]) != "ID3\x04" || id3v2TagSize(tag) != len(tag) {
+		t.Errorf("invalid tag header %x", tag[:id3v2HeaderSize])
+		t.FailNow()
+	}
+
+	frames := make(map[string][]byte)
+
+	for data := tag[id3v2HeaderSize:]; len(data) > 0; {
+		size := int(data[4])<<21 | int(data[5])<<14 | int(data[6])<<7 | int(data[7])
+		frames[string(data[:4])] = data[10 : 10+size]
+		data = data[10+size:]
+	}
+
+	return frames
+}
+
+func TestNewID3Tag(t *testing.T) {
+	tag := NewID3Tag(audio.Tags{
+		Title:      "Глава",
+		Artist:     "alena",
+		Track:      2,
+		TrackTotal: 10,
+		Chapters:   []audio.Chapter{{Title: "Intro"}, {Start: 1500 * time.Millisecond}},
+	}, 3*time.Second)
+
+	frames := id3Frames(t, tag)
+
+	for id, want := range map[string]string{"TIT2": "\x03Глава", "TPE1": "\x03alena", "TRCK": "\x032/10", "CTOC": "toc\x00\x03\x02chp1\x00chp2\x00"} {
+		if string(frames[id]) != want {
+			t.Errorf("%s: got %q", id, frames[id])
+		}
+	}
+
+	if _, ok := frames["TALB"]; ok {
+		t.Error("empty album is written")
+	}
+
+	// the map keeps the last chapter
+	chapter := frames["CHAP"]
+
+	if string(chapter[:5]) != "chp2\x00" || binary.BigEndian.Uint32(chapter[5:]) != 1500 || binary.BigEndian.Uint32(chapter[9:]) != 3000 {
+		t.Errorf("got %x", chapter)
+	}
+}
+
+func TestSetTags(t *testing.T) {
+	var (
+		frames = testFrames(10, 9, 0x11)
+		old    = append([]byte{'I', 'D', '3', 3, 0, 0, 0, 0, 0, 3}, "old"...)
+		out    bytes.Buffer
+	)
+
+	if err := SetTags(&out, bytes.NewReader(append(old, frames...)), audio.Tags{Chapters: []audio.Chapter{{Title: "All"}}}); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	tag := out.Bytes()[:id3v2TagSize(out.Bytes())]
+
+	if !bytes.Equal(out.Bytes()[len(tag):], frames) {
+		t.Error("the frames are changed")
+	}
+
+	// ten frames of 1152 samples at 44100 Hz
+	if chapter := id3Frames(t, tag)["CHAP"]; binary.BigEndian.Uint32(chapter[9:]) != 261 {
+		t.Errorf("got %x", chapter)
+	}
+
+	if err := SetTags(&out, bytes.NewReader(old), audio.Tags{}); err != ErrNoFrames {
+		t.Errorf("got %v", err)
+	}
+}
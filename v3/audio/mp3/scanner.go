@@ -0,0 +1,140 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package mp3
+
+import (
+	"bufio"
+	"io"
+)
+
+const (
+	id3v2HeaderSize = 10
+	id3v1Size       = 128
+)
+
+// Scanner reads the frames of the stream, the ID3 tags and the junk between the frames are skipped
+type Scanner struct {
+	r       *bufio.Reader
+	tag     []byte
+	started bool
+	// Skipped is the number of the junk bytes skipped between the frames
+	Skipped int
+}
+
+// NewScanner returns the scanner of the stream read from r
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{r: bufio.NewReader(r)}
+}
+
+// Tag returns the ID3v2 tag at the start of the stream, it is read with the first frame
+func (s *Scanner) Tag() []byte {
+	return s.tag
+}
+
+// Next returns the next frame, io.EOF is returned at the end of the stream,
+// the truncated last frame is dropped and the other read errors are returned
+func (s *Scanner) Next() (Frame, error) {
+	for {
+		if size, err := s.id3v2Size(); err != nil {
+			return Frame{}, err
+		} else if size > 0 {
+			tag := make([]byte, size)
+
+			if _, err = io.ReadFull(s.r, tag); err != nil {
+				return Frame{}, endOfStream(err)
+			} else if !s.started {
+				s.tag = tag
+			}
+
+			s.started = true
+
+			continue
+		}
+
+		s.started = true
+		data, err := s.r.Peek(FrameHeaderSize)
+
+		if err != nil {
+			return Frame{}, endOfStream(err)
+		} else if string(data[:3]) == "TAG" {
+			if tail, err := s.r.Peek(id3v1Size); len(tail) == id3v1Size {
+				_, _ = s.r.Discard(id3v1Size)
+
+				continue
+			} else if err != io.EOF {
+				return Frame{}, err
+			}
+		}
+
+		header, err := ParseFrameHeader(data)
+
+		if err != nil {
+			_, _ = s.r.Discard(1)
+			s.Skipped++
+
+			continue
+		}
+
+		frame := Frame{FrameHeader: header, Data: make([]byte, header.Size)}
+
+		if _, err = io.ReadFull(s.r, frame.Data); err != nil {
+			return Frame{}, endOfStream(err)
+		}
+
+		return frame, nil
+	}
+}
+
+// endOfStream returns io.EOF for the end of the stream and the truncated last frame,
+// the other read errors are returned as they are
+func endOfStream(err error) error {
+	if err == io.ErrUnexpectedEOF {
+		return io.EOF
+	}
+
+	return err
+}
+
+// id3v2Size returns the size of the ID3v2 tag at the current position, zero when there is no tag
+func (s *Scanner) id3v2Size() (int, error) {
+	data, err := s.r.Peek(id3v2HeaderSize)
+
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return 0, err
+	} else if len(data) < id3v2HeaderSize || string(data[:3]) != "ID3" {
+		return 0, nil
+	}
+
+	return id3v2TagSize(data), nil
+}
+
+// id3v2TagSize returns the size of the ID3v2 tag of the given header including the footer
+func id3v2TagSize(header []byte) int {
+	size := id3v2HeaderSize + (int(header[6]&0x7f)<<21 | int(header[7]&0x7f)<<14 | int(header[8]&0x7f)<<7 | int(header[9]&0x7f))
+
+	if header[5]&0x10 != 0 {
+		// the footer
+		size += id3v2HeaderSize
+	}
+
+	return size
+}
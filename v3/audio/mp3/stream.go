@@ -0,0 +1,191 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package mp3
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrNoFrames       = errors.New("no mpeg audio frames")
+	ErrNoStreams      = errors.New("no streams to concatenate")
+	ErrFormatMismatch = errors.New("mpeg audio streams have different formats")
+)
+
+type (
+	// Info is the summary of the MPEG audio stream
+	Info struct {
+		Header FrameHeader
+		// Frames is the number of the audio frames without the Xing header frame
+		Frames int
+		// Delay and Padding are the gapless samples of the LAME extension
+		Delay   int
+		Padding int
+		// Duration is the playback duration without the encoder delay and padding
+		Duration time.Duration
+	}
+
+	// stream is the audio frames of a single stream with its encoder header
+	stream struct {
+		tag    []byte
+		xing   XingHeader
+		frames []Frame
+	}
+)
+
+// Probe reads the stream to the end and returns its summary
+func Probe(r io.Reader) (Info, error) {
+	s, err := readStream(r)
+
+	if err != nil {
+		return Info{}, err
+	}
+
+	info := Info{
+		Header:  s.frames[0].FrameHeader,
+		Frames:  len(s.frames),
+		Delay:   s.xing.Delay,
+		Padding: s.xing.Padding,
+	}
+
+	samples := -info.Delay - info.Padding
+
+	for _, frame := range s.frames {
+		samples += frame.Samples
+	}
+
+	if samples > 0 {
+		info.Duration = time.Duration(int64(samples) * int64(time.Second) / int64(info.Header.SampleRate))
+	}
+
+	return info, nil
+}
+
+// Duration returns the playback duration of the stream
+func Duration(r io.Reader) (time.Duration, error) {
+	info, err := Probe(r)
+
+	return info.Duration, err
+}
+
+// Concat joins the MPEG audio streams of the same format into a single stream written to w.
+// The ID3v2 tag of the first stream is kept, the other tags and the encoder headers are dropped
+// and a single Info header is written with the total frames, the encoder delay of the first stream
+// and the padding of the last one. The delay and the padding between the streams can not be
+// removed without decoding and are played.
+func Concat(w io.Writer, streams ...io.Reader) error {
+	if len(streams) == 0 {
+		return ErrNoStreams
+	}
+
+	var joined stream
+
+	for i, r := range streams {
+		s, err := readStream(r)
+
+		if err != nil {
+			return err
+		}
+
+		if i == 0 {
+			joined.tag, joined.xing.Delay = s.tag, s.xing.Delay
+		} else if !s.frames[0].compatible(joined.frames[0].FrameHeader) {
+			return ErrFormatMismatch
+		}
+
+		for _, frame := range s.frames {
+			if !frame.compatible(s.frames[0].FrameHeader) {
+				return ErrFormatMismatch
+			}
+
+			joined.frames = append(joined.frames, frame)
+			joined.xing.Bytes += frame.Size
+		}
+
+		joined.xing.Padding = s.xing.Padding
+	}
+
+	joined.xing.Frames = len(joined.frames)
+
+	for _, frame := range joined.frames {
+		joined.xing.VBR = joined.xing.VBR || frame.Bitrate != joined.frames[0].Bitrate
+	}
+
+	if _, err := w.Write(joined.tag); err != nil {
+		return err
+	}
+
+	if joined.frames[0].Layer == 3 {
+		header := NewXingFrame(joined.frames[0].FrameHeader, joined.xing)
+		joined.xing.Bytes += header.Size
+		header = NewXingFrame(joined.frames[0].FrameHeader, joined.xing)
+
+		if _, err := w.Write(header.Data); err != nil {
+			return err
+		}
+	}
+
+	for _, frame := range joined.frames {
+		if _, err := w.Write(frame.Data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readStream reads the audio frames of the stream, the leading encoder header frame is parsed and dropped
+func readStream(r io.Reader) (stream, error) {
+	var (
+		s       stream
+		scanner = NewScanner(r)
+		header  bool
+	)
+
+	for {
+		frame, err := scanner.Next()
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return s, err
+		}
+
+		if len(s.frames) == 0 && !header {
+			if s.xing, header = ParseXing(frame); header {
+				continue
+			}
+		}
+
+		s.frames = append(s.frames, frame)
+	}
+
+	if len(s.frames) == 0 {
+		return s, ErrNoFrames
+	}
+
+	s.tag = scanner.Tag()
+
+	return s, nil
+}
@@ -0,0 +1,120 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package mp3
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestXingRoundTrip(t *testing.T) {
+	header, _ := ParseFrameHeader([]byte{0xff, 0xfb, 0x90, 0xc0})
+	want := XingHeader{Frames: 100, Bytes: 41700, Delay: 576, Padding: 1234, VBR: true}
+	frame := NewXingFrame(header, want)
+
+	parsed, err := ParseFrameHeader(frame.Data)
+
+	if err != nil || parsed.Size != len(frame.Data) || !parsed.compatible(header) {
+		t.Errorf("got %+v, %v", parsed, err)
+	}
+
+	if got, ok := ParseXing(frame); !ok || got != want {
+		t.Errorf("got %+v", got)
+	}
+
+	offset := FrameHeaderSize + frame.sideInfoSize() + 20
+
+	if crc := frame.Data[offset+lameCRCOffset:]; uint16(crc[0])<<8|uint16(crc[1]) != crc16(frame.Data[:offset+lameCRCOffset]) {
+		t.Error("invalid crc")
+	}
+}
+
+func TestProbe(t *testing.T) {
+	header, _ := ParseFrameHeader([]byte{0xff, 0xfb, 0x90, 0xc0})
+	xing := NewXingFrame(header, XingHeader{Frames: 10, Delay: 576, Padding: 576})
+	data := append(append([]byte{}, xing.Data...), testFrames(10, 9, 0x11)...)
+
+	info, err := Probe(bytes.NewReader(data))
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if info.Frames != 10 || info.Delay != 576 || info.Padding != 576 || info.Header.SampleRate != 44100 {
+		t.Errorf("got %+v", info)
+	}
+
+	if want := time.Duration(10*1152-1152) * time.Second / 44100; info.Duration != want {
+		t.Errorf("got %s, want %s", info.Duration, want)
+	}
+
+	if _, err = Probe(bytes.NewReader([]byte("not an mp3"))); err != ErrNoFrames {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	header, _ := ParseFrameHeader([]byte{0xff, 0xfb, 0x90, 0xc0})
+	var (
+		tag    = append([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 5}, "first"...)
+		first  = append(append(tag, NewXingFrame(header, XingHeader{Delay: 576, Padding: 100}).Data...), testFrames(4, 9, 0x11)...)
+		second = append(NewXingFrame(header, XingHeader{Delay: 576, Padding: 200}).Data, testFrames(3, 10, 0x22)...)
+		out    bytes.Buffer
+	)
+
+	if err := Concat(&out, bytes.NewReader(first), bytes.NewReader(second)); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if !bytes.HasPrefix(out.Bytes(), tag) {
+		t.Error("the tag of the first stream is lost")
+	}
+
+	scanner := NewScanner(bytes.NewReader(out.Bytes()))
+	frame, _ := scanner.Next()
+	xing, ok := ParseXing(frame)
+
+	if !ok || xing.Frames != 7 || xing.Delay != 576 || xing.Padding != 200 || !xing.VBR || xing.Bytes != out.Len()-len(tag) {
+		t.Errorf("got %+v", xing)
+	}
+
+	info, err := Probe(bytes.NewReader(out.Bytes()))
+
+	if err != nil || info.Frames != 7 {
+		t.Errorf("got %+v, %v", info, err)
+	}
+
+	mono22k := []byte{0xff, 0xf3, 0x60, 0xc0}
+	other, _ := ParseFrameHeader(mono22k)
+	mismatch := append(mono22k, make([]byte, other.Size-4)...)
+
+	if err = Concat(&out, bytes.NewReader(first), bytes.NewReader(mismatch)); err != ErrFormatMismatch {
+		t.Errorf("got %v", err)
+	}
+
+	if err = Concat(&out); err != ErrNoStreams {
+		t.Errorf("got %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package mp3
+
+import (
+	"encoding/binary"
+)
+
+const (
+	xingFlagFrames  = 0x01
+	xingFlagBytes   = 0x02
+	xingFlagTOC     = 0x04
+	xingFlagQuality = 0x08
+
+	xingTOCSize = 100
+	// lameTagSize is the size of the LAME extension following the Xing fields
+	lameTagSize = 36
+	// lameDelayOffset is the offset of the 12-bit encoder delay and padding in the LAME extension
+	lameDelayOffset = 21
+	// lameMusicLengthOffset is the offset of the music length in the LAME extension
+	lameMusicLengthOffset = 28
+	// lameCRCOffset is the offset of the info tag crc in the LAME extension
+	lameCRCOffset = 34
+	// vbriOffset is the fixed offset of the Fraunhofer VBRI header
+	vbriOffset = FrameHeaderSize + 32
+)
+
+// XingHeader is the Xing/Info or VBRI header frame of the encoder, it carries no audio
+type XingHeader struct {
+	// Frames is the number of the audio frames, zero when unknown
+	Frames int
+	// Bytes is the size of the stream starting with the header frame, zero when unknown
+	Bytes int
+	// Delay and Padding are the encoder delay and padding samples of the LAME extension
+	Delay   int
+	Padding int
+	// VBR is set for the Xing header and unset for the Info header of the constant bitrate
+	VBR bool
+}
+
+// ParseXing parses the Xing/Info header of the layer III frame or detects the VBRI one
+func ParseXing(frame Frame) (XingHeader, bool) {
+	if frame.Layer != 3 {
+		return XingHeader{}, false
+	}
+
+	var (
+		header XingHeader
+		data   = frame.Data
+		offset = FrameHeaderSize + frame.sideInfoSize()
+	)
+
+	if len(data) >= vbriOffset+4 && string(data[vbriOffset:vbriOffset+4]) == "VBRI" {
+		return XingHeader{VBR: true}, true
+	} else if len(data) < offset+8 {
+		return header, false
+	}
+
+	switch string(data[offset : offset+4]) {
+	case "Xing":
+		header.VBR = true
+	case "Info":
+	default:
+		return header, false
+	}
+
+	flags := binary.BigEndian.Uint32(data[offset+4:])
+	offset += 8
+
+	for _, field := range []struct {
+		flag  uint32
+		value *int
+		size  int
+	}{
+		{flag: xingFlagFrames, value: &header.Frames, size: 4},
+		{flag: xingFlagBytes, value: &header.Bytes, size: 4},
+		{flag: xingFlagTOC, size: xingTOCSize},
+		{flag: xingFlagQuality, size: 4},
+	} {
+		if flags&field.flag == 0 {
+			continue
+		} else if len(data) < offset+field.size {
+			return header, true
+		} else if field.value != nil {
+			*field.value = int(binary.BigEndian.Uint32(data[offset:]))
+		}
+
+		offset += field.size
+	}
+
+	if len(data) >= offset+lameTagSize && isEncoderTag(data[offset:offset+4]) {
+		delay := data[offset+lameDelayOffset:]
+		header.Delay = int(delay[0])<<4 | int(delay[1]>>4)
+		header.Padding = int(delay[1]&0x0f)<<8 | int(delay[2])
+	}
+
+	return header, true
+}
+
+// NewXingFrame returns the Info frame for the audio frames of the given header, the smallest bitrate
+// fitting the LAME extension is used. The Xing tag is written when the audio has the variable bitrate.
+func NewXingFrame(audio FrameHeader, xing XingHeader) Frame {
+	header := audio
+	header.Padding = false
+	header.Protected = false
+
+	var (
+		offset = FrameHeaderSize + header.sideInfoSize()
+		needed = offset + 20 + lameTagSize
+		mpeg1  = 0
+	)
+
+	if header.Version == versionMPEG1 {
+		mpeg1 = 1
+	}
+
+	for index := 1; index < 15; index++ {
+		header.Bitrate = bitrates[mpeg1][header.Layer-1][index] * 1000
+		header.Samples, header.Size = header.frameSize(header.Bitrate)
+
+		if header.Size >= needed {
+			header.raw[2] = byte(index)<<4 | header.raw[2]&0x0c
+			break
+		}
+	}
+
+	// no crc and no padding
+	header.raw[1] |= 0x01
+	data := make([]byte, header.Size)
+	copy(data, header.raw[:])
+
+	tag := "Info"
+
+	if xing.VBR {
+		tag = "Xing"
+	}
+
+	copy(data[offset:], tag)
+	binary.BigEndian.PutUint32(data[offset+4:], xingFlagFrames|xingFlagBytes|xingFlagQuality)
+	binary.BigEndian.PutUint32(data[offset+8:], uint32(xing.Frames))
+	binary.BigEndian.PutUint32(data[offset+12:], uint32(xing.Bytes))
+
+	lame := data[offset+20:]
+	copy(lame, "LAME3.100")
+	lame[lameDelayOffset] = byte(xing.Delay >> 4)
+	lame[lameDelayOffset+1] = byte(xing.Delay&0x0f)<<4 | byte(xing.Padding>>8&0x0f)
+	lame[lameDelayOffset+2] = byte(xing.Padding)
+	binary.BigEndian.PutUint32(lame[lameMusicLengthOffset:], uint32(xing.Bytes))
+	binary.BigEndian.PutUint16(lame[lameCRCOffset:], crc16(data[:offset+20+lameCRCOffset]))
+
+	frame := Frame{FrameHeader: header, Data: data}
+	frame.raw = [FrameHeaderSize]byte{data[0], data[1], data[2], data[3]}
+
+	return frame
+}
+
+// isEncoderTag reports whether the bytes start the LAME extension written by LAME or libavcodec
+func isEncoderTag(data []byte) bool {
+	switch string(data) {
+	case "LAME", "Lavf", "Lavc", "GOGO":
+		return true
+	}
+
+	return false
+}
+
+// crc16 is the crc of the LAME info tag with the reflected 0x8005 polynomial
+func crc16(data []byte) uint16 {
+	var crc uint16
+
+	for _, b := range data {
+		crc ^= uint16(b)
+
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xa001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+
+	return crc
+}
@@ -0,0 +1,59 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"context"
+	"errors"
+	"github.com/lEx0/yatts/v3/request"
+	"io"
+)
+
+var (
+	ErrNoAudioTemplates = errors.New("audio templates registry is not set")
+)
+
+// SetAudioTemplates sets the registry used by SpeakAudioTemplate
+func (y *YaTTS) SetAudioTemplates(registry *request.AudioTemplateRegistry) {
+	y.templates = registry
+}
+
+// SpeakAudioTemplate synthesizes the registered audio template,
+// the text variables override the values of the template definition
+func (y *YaTTS) SpeakAudioTemplate(
+	ctx context.Context,
+	name string,
+	textVariables map[string]string,
+	options ...request.Option,
+) (io.Reader, error) {
+	if y.templates == nil {
+		return nil, ErrNoAudioTemplates
+	}
+
+	entity, err := y.templates.Entity(name, textVariables)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return y.Speak(ctx, entity, options...)
+}
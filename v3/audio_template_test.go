@@ -0,0 +1,89 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"github.com/lEx0/yatts/v3/auth"
+	"github.com/lEx0/yatts/v3/request"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
+	"io/ioutil"
+	"testing"
+)
+
+func TestYaTTS_SpeakAudioTemplate(t *testing.T) {
+	var got *tts.UtteranceSynthesisRequest
+
+	client := &YaTTS{
+		auth:    auth.NewAPITokenAuth("token", ""),
+		options: []request.Option{request.OutputFormat(request.OutputFormatOggOpus)},
+		client: fakeSynthesizer{audio: func(req *tts.UtteranceSynthesisRequest) [][]byte {
+			got = req
+
+			return [][]byte{{1, 2, 3}}
+		}},
+	}
+
+	if _, err := client.SpeakAudioTemplate(context.Background(), "order", nil); !errors.Is(err, ErrNoAudioTemplates) {
+		t.Errorf("missing registry must be reported, got %v", err)
+	}
+
+	registry, err := request.NewAudioTemplateRegistry(request.AudioTemplateDefinition{
+		Name:     "order",
+		Template: "Ваш заказ {order} готов.",
+		Variables: map[string]request.AudioVariableDefinition{
+			"order": {Text: "1", Reference: "7", StartMs: 0, LengthMs: 300},
+		},
+		Audio: request.AudioDefinition{
+			Content:    base64.StdEncoding.EncodeToString(make([]byte, 16000)),
+			Format:     request.OutputFormatLPCM,
+			SampleRate: 8000,
+		},
+	})
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	client.SetAudioTemplates(registry)
+
+	stream, err := client.SpeakAudioTemplate(context.Background(), "order", map[string]string{"order": "42"})
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if _, err = ioutil.ReadAll(stream); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	variables := got.GetTextTemplate().GetVariables()
+
+	if len(variables) != 1 || variables[0].VariableName != "{order}" || variables[0].VariableValue != "42" || got.Model != "zsl" {
+		t.Errorf("template must be synthesized with the call variables, got %v", got)
+	}
+}
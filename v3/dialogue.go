@@ -0,0 +1,240 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"context"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/audio/oggopus"
+	"github.com/lEx0/yatts/audio/pcm"
+	"github.com/lEx0/yatts/v3/request"
+	"io"
+	"sync"
+	"time"
+)
+
+type (
+	// DialogueTiming is the position of the dialogue turn in the synthesized stream
+	DialogueTiming struct {
+		Speaker string
+		Start   time.Duration
+		End     time.Duration
+	}
+
+	// DialogueStream is the audio stream of the whole dialogue in the dialogue output format
+	DialogueStream struct {
+		reader   *io.PipeReader
+		cancel   context.CancelFunc
+		mu       sync.Mutex
+		timeline []DialogueTiming
+	}
+
+	// dialogueJoiner writes the turns of the dialogue into the stream of the dialogue output format
+	dialogueJoiner interface {
+		// turn writes the turn audio and returns its position in the stream
+		turn(r io.Reader) (start, end time.Duration, err error)
+		// gap writes the silence between the turns
+		gap() error
+		close() error
+	}
+
+	// rawJoiner joins the raw lpcm and G.711 turns
+	rawJoiner struct {
+		w        io.Writer
+		format   audio.WAVFormat
+		silence  []byte
+		position int64
+	}
+
+	// oggJoiner joins the ogg opus turns into a single logical stream
+	oggJoiner struct {
+		joiner  *oggopus.Joiner
+		silence time.Duration
+	}
+)
+
+// SpeakDialogue synthesizes every turn of the dialogue and joins them into a single stream
+// of the dialogue output format separated by the dialogue gap. The turns are requested one by one
+// while the stream is read and the G.711 turns are encoded locally like the single requests.
+func (y *YaTTS) SpeakDialogue(
+	ctx context.Context,
+	dialogue request.DialogueEntity,
+	options ...request.Option,
+) (*DialogueStream, error) {
+	requests, err := dialogue.Requests(appendOptions(y.options, options...)...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sent  = make([]*request.Request, 0, len(requests))
+		codec audio.G711
+	)
+
+	// the turns have the same output format, so the same codec
+	for _, r := range requests {
+		s, c := convert(r)
+		sent, codec = append(sent, s), c
+	}
+
+	cctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+	stream := &DialogueStream{reader: pr, cancel: cancel}
+	joiner := newDialogueJoiner(pw, dialogue.Gap, sent[0], codec)
+
+	go func() {
+		defer cancel()
+
+		for i := range sent {
+			if i > 0 {
+				if err := joiner.gap(); err != nil {
+					_ = pw.CloseWithError(err)
+
+					return
+				}
+			}
+
+			start, end, err := y.speakTurn(cctx, sent[i], codec, joiner)
+
+			if err != nil {
+				_ = pw.CloseWithError(err)
+
+				return
+			}
+
+			stream.mu.Lock()
+			stream.timeline = append(stream.timeline, DialogueTiming{
+				Speaker: dialogue.Turns[i].Speaker,
+				Start:   start,
+				End:     end,
+			})
+			stream.mu.Unlock()
+		}
+
+		_ = pw.CloseWithError(joiner.close())
+	}()
+
+	return stream, nil
+}
+
+// newDialogueJoiner returns the joiner of the dialogue output format writing to w
+func newDialogueJoiner(w io.Writer, gap time.Duration, sent *request.Request, codec audio.G711) dialogueJoiner {
+	if sent.OutputFormat == request.OutputFormatOggOpus {
+		return &oggJoiner{joiner: oggopus.NewJoiner(w), silence: gap}
+	}
+
+	joiner := &rawJoiner{
+		w:       w,
+		format:  audio.PCMFormat(sent.SampleRate),
+		silence: make([]byte, pcm.Size(gap, sent.SampleRate)),
+	}
+
+	if codec != 0 {
+		joiner.format, joiner.silence = codec.WAVFormat(), audio.EncodeG711(codec, joiner.silence)
+	}
+
+	return joiner
+}
+
+// speakTurn synthesizes the turn and writes the encoded audio with the joiner
+func (y *YaTTS) speakTurn(
+	ctx context.Context,
+	sent *request.Request,
+	codec audio.G711,
+	joiner dialogueJoiner,
+) (time.Duration, time.Duration, error) {
+	body, err := y.synthesize(ctx, sent, codec)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer func() { _ = body.Close() }()
+
+	return joiner.turn(body)
+}
+
+func (j *rawJoiner) turn(r io.Reader) (time.Duration, time.Duration, error) {
+	start := j.format.Duration(j.position)
+	written, err := io.Copy(j.w, r)
+	j.position += written
+
+	return start, j.format.Duration(j.position), err
+}
+
+func (j *rawJoiner) gap() error {
+	written, err := j.w.Write(j.silence)
+	j.position += int64(written)
+
+	return err
+}
+
+func (j *rawJoiner) close() error {
+	return nil
+}
+
+func (j *oggJoiner) turn(r io.Reader) (time.Duration, time.Duration, error) {
+	start := j.joiner.Duration()
+	err := j.joiner.Add(r)
+
+	return start, j.joiner.Duration(), err
+}
+
+func (j *oggJoiner) gap() error {
+	return j.joiner.AddSilence(j.silence)
+}
+
+func (j *oggJoiner) close() error {
+	return j.joiner.Close()
+}
+
+// Read reads the dialogue audio
+func (s *DialogueStream) Read(p []byte) (int, error) {
+	return s.reader.Read(p)
+}
+
+// Close stops the synthesis of the remaining turns
+func (s *DialogueStream) Close() error {
+	s.cancel()
+
+	return s.reader.Close()
+}
+
+// Timeline returns the positions of the turns synthesized so far,
+// it is complete once the stream is read to the end
+func (s *DialogueStream) Timeline() []DialogueTiming {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]DialogueTiming(nil), s.timeline...)
+}
+
+// SpeakMixedLanguage synthesizes every language run of the text by its routed voice
+// and joins them into a single stream, the timeline speakers are the run languages
+func (y *YaTTS) SpeakMixedLanguage(
+	ctx context.Context,
+	entity request.MixedLanguageEntity,
+	options ...request.Option,
+) (*DialogueStream, error) {
+	return y.SpeakDialogue(ctx, entity.Dialogue(), options...)
+}
@@ -0,0 +1,240 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"bytes"
+	"context"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/audio/oggopus"
+	"github.com/lEx0/yatts/v3/auth"
+	"github.com/lEx0/yatts/v3/request"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
+	"google.golang.org/grpc"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+type (
+	fakeSynthesizer struct {
+		// audio returns the audio chunks of the utterance
+		audio func(req *tts.UtteranceSynthesisRequest) [][]byte
+	}
+
+	fakeUtteranceClient struct {
+		grpc.ClientStream
+		chunks [][]byte
+	}
+)
+
+func (s fakeSynthesizer) UtteranceSynthesis(
+	_ context.Context,
+	in *tts.UtteranceSynthesisRequest,
+	_ ...grpc.CallOption,
+) (tts.Synthesizer_UtteranceSynthesisClient, error) {
+	return &fakeUtteranceClient{chunks: s.audio(in)}, nil
+}
+
+func (c *fakeUtteranceClient) Recv() (*tts.UtteranceSynthesisResponse, error) {
+	if len(c.chunks) == 0 {
+		return nil, io.EOF
+	}
+
+	chunk := c.chunks[0]
+	c.chunks = c.chunks[1:]
+
+	return &tts.UtteranceSynthesisResponse{AudioChunk: &tts.AudioChunk{Data: chunk}}, nil
+}
+
+func TestYaTTS_SpeakDialogue(t *testing.T) {
+	client := &YaTTS{
+		auth: auth.NewAPITokenAuth("token", ""),
+		options: []request.Option{
+			request.OutputFormat(request.OutputFormatLPCM),
+			request.SampleRate(request.OutputSampleRate8k),
+		},
+		client: fakeSynthesizer{audio: func(req *tts.UtteranceSynthesisRequest) [][]byte {
+			// 100ms of the voice for alena and 200ms for filipp in two chunks
+			if req.Hints[0].GetVoice() == "filipp" {
+				return [][]byte{bytes.Repeat([]byte{1}, 1600), bytes.Repeat([]byte{1}, 1600)}
+			}
+
+			return [][]byte{bytes.Repeat([]byte{1}, 1600)}
+		}},
+	}
+
+	stream, err := client.SpeakDialogue(context.Background(), request.DialogueEntity{
+		Turns: []request.DialogueTurn{
+			{Speaker: "alice", Text: "Привет"},
+			{Speaker: "bob", Text: "Здравствуй"},
+		},
+		Speakers: map[string][]request.Option{
+			"alice": {request.Voice(request.VoiceAlena)},
+			"bob":   {request.Voice(request.VoiceFilipp)},
+		},
+		Gap: 50 * time.Millisecond,
+	})
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	data, err := ioutil.ReadAll(stream)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if len(data) != 1600+800+3200 || !bytes.Equal(data[1600:2400], make([]byte, 800)) {
+		t.Error("turns must be joined with the silence gap")
+		t.FailNow()
+	}
+
+	timeline := stream.Timeline()
+	expected := []DialogueTiming{
+		{Speaker: "alice", Start: 0, End: 100 * time.Millisecond},
+		{Speaker: "bob", Start: 150 * time.Millisecond, End: 350 * time.Millisecond},
+	}
+
+	if len(timeline) != len(expected) {
+		t.Errorf("timeline must contain %d turns, got %d", len(expected), len(timeline))
+		t.FailNow()
+	}
+
+	for i := range expected {
+		if timeline[i] != expected[i] {
+			t.Errorf("turn #%d timing must be %v, got %v", i, expected[i], timeline[i])
+		}
+	}
+
+	_ = stream.Close()
+}
+
+func TestYaTTS_SpeakDialogueG711(t *testing.T) {
+	client := &YaTTS{
+		auth:    auth.NewAPITokenAuth("token", ""),
+		options: []request.Option{request.OutputFormat(request.OutputFormatALaw)},
+		client: fakeSynthesizer{audio: func(req *tts.UtteranceSynthesisRequest) [][]byte {
+			if req.OutputAudioSpec.GetRawAudio().GetSampleRateHertz() != 8000 {
+				t.Error("G.711 turns must be synthesized as 8 kHz lpcm")
+			}
+
+			// an odd chunk boundary must not break the samples
+			return [][]byte{bytes.Repeat([]byte{1}, 801), bytes.Repeat([]byte{1}, 799)}
+		}},
+	}
+
+	stream, err := client.SpeakDialogue(context.Background(), request.DialogueEntity{
+		Turns: []request.DialogueTurn{
+			{Speaker: "alice", Text: "Привет"},
+			{Speaker: "alice", Text: "Пока"},
+		},
+		Speakers: map[string][]request.Option{"alice": {request.Voice(request.VoiceAlena)}},
+		Gap:      50 * time.Millisecond,
+	})
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	data, err := ioutil.ReadAll(stream)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	voice := audio.EncodeG711(audio.G711ALaw, bytes.Repeat([]byte{1}, 1600))
+	gap := audio.EncodeG711(audio.G711ALaw, make([]byte, 800))
+
+	if !bytes.Equal(data, append(append(append([]byte(nil), voice...), gap...), voice...)) {
+		t.Error("turns must be encoded and joined with the encoded gap")
+		t.FailNow()
+	}
+
+	timeline := stream.Timeline()
+
+	if len(timeline) != 2 || timeline[1].Start != 150*time.Millisecond || timeline[1].End != 250*time.Millisecond {
+		t.Errorf("timeline must be measured in the G.711 samples, got %v", timeline)
+	}
+}
+
+func TestYaTTS_SpeakDialogueOggOpus(t *testing.T) {
+	client := &YaTTS{
+		auth:    auth.NewAPITokenAuth("token", ""),
+		options: []request.Option{request.OutputFormat(request.OutputFormatOggOpus)},
+		client: fakeSynthesizer{audio: func(req *tts.UtteranceSynthesisRequest) [][]byte {
+			// 100ms of the voice for alena and 200ms for filipp split at an arbitrary boundary
+			var stream bytes.Buffer
+
+			d := 100 * time.Millisecond
+
+			if req.Hints[0].GetVoice() == "filipp" {
+				d = 200 * time.Millisecond
+			}
+
+			_ = oggopus.Silence(&stream, 1, d)
+
+			return [][]byte{stream.Bytes()[:100], stream.Bytes()[100:]}
+		}},
+	}
+
+	stream, err := client.SpeakDialogue(context.Background(), request.DialogueEntity{
+		Turns: []request.DialogueTurn{
+			{Speaker: "alice", Text: "Привет"},
+			{Speaker: "bob", Text: "Здравствуй"},
+		},
+		Speakers: map[string][]request.Option{
+			"alice": {request.Voice(request.VoiceAlena)},
+			"bob":   {request.Voice(request.VoiceFilipp)},
+		},
+		Gap: 60 * time.Millisecond,
+	})
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	data, err := ioutil.ReadAll(stream)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if info, err := oggopus.Probe(bytes.NewReader(data)); err != nil || info.Duration != 360*time.Millisecond {
+		t.Errorf("turns must be joined into a single stream, got %v %v", info.Duration, err)
+	}
+
+	timeline := stream.Timeline()
+
+	if len(timeline) != 2 || timeline[0].End != 100*time.Millisecond ||
+		timeline[1].Start != 160*time.Millisecond || timeline[1].End != 360*time.Millisecond {
+		t.Errorf("timeline must be measured in the granule positions, got %v", timeline)
+	}
+}
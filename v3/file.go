@@ -0,0 +1,149 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/v3/request"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrUnknownFileExtension = errors.New("unknown audio file extension")
+	ErrUntaggableFile       = errors.New("tags are not supported by the audio file")
+)
+
+// FileOption post-processes the saved audio file
+type FileOption func(path string) error
+
+var (
+	g711Formats = map[string]audio.G711{
+		string(request.OutputFormatULaw): audio.G711ULaw,
+		string(request.OutputFormatALaw): audio.G711ALaw,
+	}
+
+	// fileFormats maps the extensions of the audio files to their output formats
+	fileFormats = map[string]request.Option{
+		".ulaw": request.OutputFormat(request.OutputFormatULaw),
+		".alaw": request.OutputFormat(request.OutputFormatALaw),
+		".pcm":  request.OutputFormat(request.OutputFormatLPCM),
+		".raw":  request.OutputFormat(request.OutputFormatLPCM),
+		".ogg":  request.OutputFormat(request.OutputFormatOggOpus),
+		".opus": request.OutputFormat(request.OutputFormatOggOpus),
+		".mp3":  request.OutputFormat(request.OutputFormatMp3),
+		".wav":  wavOutputFormat,
+	}
+)
+
+// SaveFile synthesizes the entity into the file of the format chosen by the extension:
+// .ulaw and .alaw are the raw G.711, .pcm and .raw are the raw lpcm, .ogg and .opus are the oggopus,
+// .mp3 is the mp3 and .wav is the wav of the API or the G.711 format given in the options wrapped
+// into the wav container. The file is removed when the synthesis fails.
+func (y *YaTTS) SaveFile(ctx context.Context, path string, entity request.TextEntity, options ...request.Option) error {
+	return y.SaveFileWith(ctx, path, entity, nil, options...)
+}
+
+// SaveFileWith synthesizes the entity into the file like SaveFile and post-processes the saved file
+// with the file options, the file is removed when the synthesis or the post-processing fails
+func (y *YaTTS) SaveFileWith(
+	ctx context.Context,
+	path string,
+	entity request.TextEntity,
+	fileOptions []FileOption,
+	options ...request.Option,
+) error {
+	ext := strings.ToLower(filepath.Ext(path))
+	format, ok := fileFormats[ext]
+
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownFileExtension, ext)
+	}
+
+	f, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	stream, codec, err := y.speak(ctx, entity, appendOptions(options, format)...)
+
+	if err == nil {
+		defer func() { _ = stream.Close() }()
+	}
+
+	switch {
+	case err != nil:
+		// the file is removed below
+	case ext == ".wav" && codec != 0:
+		err = writeWAV(f, stream, codec.WAVFormat())
+	default:
+		_, err = io.Copy(f, stream)
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	for _, option := range fileOptions {
+		if err != nil {
+			break
+		}
+
+		err = option(path)
+	}
+
+	if err != nil {
+		_ = os.Remove(path)
+	}
+
+	return err
+}
+
+// wavOutputFormat keeps the G.711 output formats and switches the others to the wav of the API
+func wavOutputFormat(req *request.Request) error {
+	if _, ok := g711Formats[string(req.OutputFormat)]; !ok {
+		req.OutputFormat = request.OutputFormatWav
+	}
+
+	return nil
+}
+
+// writeWAV copies the samples of the format into w wrapped into the wav container
+func writeWAV(w io.Writer, samples io.Reader, format audio.WAVFormat) error {
+	wav, err := audio.NewWAVFormatWriter(w, format)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(wav, samples); err != nil {
+		return err
+	}
+
+	return wav.Close()
+}
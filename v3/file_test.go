@@ -0,0 +1,109 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/v3/auth"
+	"github.com/lEx0/yatts/v3/request"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestYaTTS_SaveFile(t *testing.T) {
+	client := &YaTTS{
+		auth:    auth.NewAPITokenAuth("token", ""),
+		options: []request.Option{request.Voice(request.VoiceAlena)},
+		client: fakeSynthesizer{audio: func(req *tts.UtteranceSynthesisRequest) [][]byte {
+			if raw := req.OutputAudioSpec.GetRawAudio(); raw != nil {
+				if raw.SampleRateHertz != audio.G711SampleRate {
+					t.Errorf("got sample rate %d", raw.SampleRateHertz)
+				}
+
+				return [][]byte{make([]byte, 1600)}
+			}
+
+			return [][]byte{[]byte("container")}
+		}},
+	}
+
+	dir, err := ioutil.TempDir("", "yatts")
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	tests := []struct {
+		name     string
+		options  []request.Option
+		expected []byte
+	}{
+		{name: "speech.ulaw", expected: bytes.Repeat([]byte{0xff}, 800)},
+		{name: "speech.alaw", expected: bytes.Repeat([]byte{0xd5}, 800)},
+		{name: "speech.mp3", expected: []byte("container")},
+		{name: "speech.wav", expected: []byte("container")},
+		{
+			name:     "ulaw.wav",
+			options:  []request.Option{request.OutputFormat(request.OutputFormatULaw)},
+			expected: append(audio.G711ULaw.WAVFormat().Header(800), bytes.Repeat([]byte{0xff}, 800)...),
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			path := filepath.Join(dir, entry.name)
+
+			if err := client.SaveFile(context.Background(), path, request.SimpleTextEntity{Text: "Привет"}, entry.options...); err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			if data, _ := ioutil.ReadFile(path); !bytes.Equal(data, entry.expected) {
+				t.Errorf("got % x", data)
+			}
+		})
+	}
+
+	if err := client.SaveFile(context.Background(), filepath.Join(dir, "speech.flac"), request.SimpleTextEntity{Text: "Привет"}); !errors.Is(err, ErrUnknownFileExtension) {
+		t.Errorf("got %v", err)
+	}
+
+	path := filepath.Join(dir, "invalid.alaw")
+	err = client.SaveFile(context.Background(), path, request.SimpleTextEntity{Text: "Привет"}, request.SampleRate(request.OutputSampleRate48k))
+
+	if !errors.Is(err, request.ErrInvalidSampleRate) {
+		t.Errorf("got %v", err)
+	}
+
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("the file of the failed synthesis is kept")
+	}
+}
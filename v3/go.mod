@@ -3,10 +3,16 @@ module github.com/lEx0/yatts/v3
 go 1.14
 
 require (
+	github.com/lEx0/yatts v0.0.0-00010101000000-000000000000
 	github.com/yandex-cloud/go-genproto v0.0.0-20240122083642-669755cf22e2
 	golang.org/x/net v0.20.0 // indirect
 	google.golang.org/genproto v0.0.0-20240125205218-1f4bbc51befe // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20240125205218-1f4bbc51befe // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240125205218-1f4bbc51befe // indirect
 	google.golang.org/grpc v1.61.0
+	google.golang.org/protobuf v1.32.0
+	gopkg.in/yaml.v3 v3.0.1
 )
+
+// the root module is not tagged yet
+replace github.com/lEx0/yatts => ../
@@ -0,0 +1,220 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/lEx0/yatts/audio/pcm"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+var (
+	ErrAudioSampleRateUnknown = errors.New("lpcm sample rate can not be detected")
+)
+
+// ReferenceAudio is the reference recording with the format, sample rate and duration read from the headers
+type ReferenceAudio struct {
+	Source     []byte
+	Format     outputFormat
+	SampleRate int
+	Duration   time.Duration
+}
+
+// ReadReferenceAudio reads wav, ogg opus or mp3 audio detected by the magic bytes,
+// wav is converted to mono lpcm and resampled to the nearest higher lpcm sample rate supported by the api,
+// headerless lpcm is rejected as its sample rate is unknown
+func ReadReferenceAudio(r io.Reader) (ReferenceAudio, error) {
+	data, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return ReferenceAudio{}, err
+	}
+
+	format := detectAudioFormat(data)
+
+	switch format {
+	case OutputFormatLPCM:
+		return ReferenceAudio{}, ErrAudioSampleRateUnknown
+	case OutputFormatWav:
+		samples, sampleRate, channels, err := parseWAV(data)
+
+		if err != nil {
+			return ReferenceAudio{}, err
+		}
+
+		data, format = downmix(samples, channels), OutputFormatLPCM
+
+		if data, sampleRate, err = resampleReference(data, sampleRate); err != nil {
+			return ReferenceAudio{}, err
+		}
+
+		return ReferenceAudio{
+			Source:     data,
+			Format:     format,
+			SampleRate: sampleRate,
+			Duration:   pcmDuration(len(data), sampleRate, 1),
+		}, nil
+	}
+
+	info, err := probeAudio(data, format, 0)
+
+	if err != nil {
+		return ReferenceAudio{}, err
+	}
+
+	return ReferenceAudio{Source: data, Format: format, SampleRate: info.sampleRate, Duration: info.duration}, nil
+}
+
+// LoadReferenceAudio reads the reference audio from the file
+func LoadReferenceAudio(path string) (ReferenceAudio, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return ReferenceAudio{}, err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	return ReadReferenceAudio(f)
+}
+
+// ReadAudioTemplateEntity builds the audio template with the reference audio read from r
+func ReadAudioTemplateEntity(
+	textTemplate string,
+	textVariables map[string]string,
+	defaultVariables map[string]AudioVariable,
+	r io.Reader,
+) (AudioTemplateEntity, error) {
+	return newAudioTemplateBuilder(textTemplate, textVariables, defaultVariables).ReadAudio(r).Build()
+}
+
+// LoadAudioTemplateEntity builds the audio template with the reference audio from the file
+func LoadAudioTemplateEntity(
+	textTemplate string,
+	textVariables map[string]string,
+	defaultVariables map[string]AudioVariable,
+	path string,
+) (AudioTemplateEntity, error) {
+	return newAudioTemplateBuilder(textTemplate, textVariables, defaultVariables).LoadAudio(path).Build()
+}
+
+// ReadAudio sets the reference audio read from r, see ReadReferenceAudio
+func (b *AudioTemplateBuilder) ReadAudio(r io.Reader) *AudioTemplateBuilder {
+	audio, err := ReadReferenceAudio(r)
+
+	return b.referenceAudio(audio, err)
+}
+
+// LoadAudio sets the reference audio read from the file, see ReadReferenceAudio
+func (b *AudioTemplateBuilder) LoadAudio(path string) *AudioTemplateBuilder {
+	audio, err := LoadReferenceAudio(path)
+
+	return b.referenceAudio(audio, err)
+}
+
+func (b *AudioTemplateBuilder) referenceAudio(audio ReferenceAudio, err error) *AudioTemplateBuilder {
+	if err != nil {
+		b.audioErr = err
+
+		return b
+	}
+
+	b.audioErr = nil
+
+	return b.Audio(audio.Source, audio.Format, audio.SampleRate)
+}
+
+func newAudioTemplateBuilder(
+	textTemplate string,
+	textVariables map[string]string,
+	defaultVariables map[string]AudioVariable,
+) *AudioTemplateBuilder {
+	b := NewAudioTemplateBuilder(textTemplate)
+
+	for name, value := range textVariables {
+		b.TextVariable(name, value)
+	}
+
+	for name, variable := range defaultVariables {
+		b.AudioVariable(name, variable)
+	}
+
+	return b
+}
+
+// resampleReference converts the mono lpcm to the nearest higher supported sample rate
+func resampleReference(samples []byte, sampleRate int) ([]byte, int, error) {
+	to := int(OutputSampleRate48k)
+
+	for _, rate := range []int{int(OutputSampleRate8k), int(OutputSampleRate16k), lpcmSampleRate22k} {
+		if rate >= sampleRate {
+			to = rate
+
+			break
+		}
+	}
+
+	if to == sampleRate {
+		return samples, sampleRate, nil
+	}
+
+	r, err := pcm.NewResampler(bytes.NewReader(samples), sampleRate, to)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resampled, err := ioutil.ReadAll(r)
+
+	return resampled, to, err
+}
+
+// downmix averages the channels of the interleaved 16-bit samples
+func downmix(samples []byte, channels int) []byte {
+	if channels == 1 {
+		return samples
+	}
+
+	var (
+		frame  = pcmSampleSize * channels
+		result = bytes.NewBuffer(make([]byte, 0, len(samples)/channels))
+		sample = make([]byte, pcmSampleSize)
+	)
+
+	for i := 0; i+frame <= len(samples); i += frame {
+		var sum int
+
+		for c := 0; c < channels; c++ {
+			sum += int(int16(binary.LittleEndian.Uint16(samples[i+c*pcmSampleSize:])))
+		}
+
+		binary.LittleEndian.PutUint16(sample, uint16(int16(sum/channels)))
+		result.Write(sample)
+	}
+
+	return result.Bytes()
+}
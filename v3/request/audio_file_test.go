@@ -0,0 +1,150 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReadReferenceAudio(t *testing.T) {
+	t.Run("wav is converted to lpcm", func(t *testing.T) {
+		audio, err := ReadReferenceAudio(bytes.NewReader(testWAV(16000, 8000)))
+
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		if audio.Format != OutputFormatLPCM || audio.SampleRate != 16000 ||
+			audio.Duration != 500*time.Millisecond || len(audio.Source) != 16000 {
+			t.Errorf("got %s %d %s %d bytes", audio.Format, audio.SampleRate, audio.Duration, len(audio.Source))
+		}
+	})
+	t.Run("wav is resampled to the supported rate", func(t *testing.T) {
+		audio, err := ReadReferenceAudio(bytes.NewReader(testWAV(44100, 44100)))
+
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		if audio.Format != OutputFormatLPCM || audio.SampleRate != 48000 ||
+			audio.Duration < time.Second-time.Millisecond || audio.Duration > time.Second+time.Millisecond {
+			t.Errorf("got %s %d %s", audio.Format, audio.SampleRate, audio.Duration)
+		}
+	})
+	t.Run("stereo wav is downmixed", func(t *testing.T) {
+		wav := testWAV(8000, 2)
+		binary.LittleEndian.PutUint16(wav[22:], 2)
+		binary.LittleEndian.PutUint16(wav[44:], uint16(1000))
+		binary.LittleEndian.PutUint16(wav[46:], uint16(0xffff-2000+1))
+
+		audio, err := ReadReferenceAudio(bytes.NewReader(wav))
+
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		if len(audio.Source) != 2 || int16(binary.LittleEndian.Uint16(audio.Source)) != -500 {
+			t.Errorf("channels must be averaged, got %v", audio.Source)
+		}
+	})
+	t.Run("ogg opus is kept", func(t *testing.T) {
+		audio, err := ReadReferenceAudio(bytes.NewReader(testOggOpus))
+
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		if audio.Format != OutputFormatOggOpus || audio.SampleRate != 48000 || audio.Duration != time.Second {
+			t.Errorf("got %s %d %s", audio.Format, audio.SampleRate, audio.Duration)
+		}
+	})
+	t.Run("mp3 is kept", func(t *testing.T) {
+		audio, err := ReadReferenceAudio(bytes.NewReader(testMP3(10)))
+
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		if audio.Format != OutputFormatMp3 || audio.SampleRate != 44100 {
+			t.Errorf("got %s %d", audio.Format, audio.SampleRate)
+		}
+	})
+	t.Run("raw lpcm is rejected", func(t *testing.T) {
+		if _, err := ReadReferenceAudio(bytes.NewReader(make([]byte, 100))); !errors.Is(err, ErrAudioSampleRateUnknown) {
+			t.Errorf("got %v", err)
+		}
+	})
+}
+
+func TestLoadAudioTemplateEntity(t *testing.T) {
+	dir, err := ioutil.TempDir("", "yatts")
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "reference.wav")
+
+	if err = ioutil.WriteFile(path, testWAV(8000, 16000), 0600); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	entity, err := LoadAudioTemplateEntity(
+		"Ваш заказ {order} готов.",
+		map[string]string{"order": "42"},
+		map[string]AudioVariable{"order": {Value: "7", Start: 500 * time.Millisecond, Length: 500 * time.Millisecond}},
+		path,
+	)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if entity.audioFormat != OutputFormatLPCM || entity.audioSampleRate != 8000 || len(entity.audioSource) != 32000 {
+		t.Errorf("got %s %d %d bytes", entity.audioFormat, entity.audioSampleRate, len(entity.audioSource))
+	}
+
+	_, err = NewAudioTemplateBuilder("Ваш заказ {order} готов.").
+		LoadAudio(filepath.Join(dir, "missing.wav")).
+		Build()
+
+	if !errors.Is(err, os.ErrNotExist) || !errors.Is(err, ErrTemplateVariables) {
+		t.Errorf("file error must be reported with the other problems, got %v", err)
+	}
+}
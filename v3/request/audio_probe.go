@@ -0,0 +1,215 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/lEx0/yatts/audio/oggopus"
+	"github.com/lEx0/yatts/v3/audio/mp3"
+	"time"
+)
+
+var (
+	ErrInvalidAudio            = errors.New("invalid audio")
+	ErrAudioFormatMismatch     = errors.New("audio format mismatch")
+	ErrAudioSampleRateMismatch = errors.New("audio sample rate mismatch")
+)
+
+const (
+	// bytes per sample of the 16-bit linear PCM
+	pcmSampleSize = 2
+	// wavFormatPCM is the format tag of the integer pcm wav
+	wavFormatPCM = 1
+)
+
+type audioInfo struct {
+	format     outputFormat
+	sampleRate int
+	duration   time.Duration
+}
+
+// detectAudioFormat detects the container by the magic bytes, the headerless mp3 is detected
+// by two consecutive frames, unknown data is treated as lpcm
+func detectAudioFormat(data []byte) outputFormat {
+	if format, ok := containerFormat(data); ok {
+		return format
+	}
+
+	first, err := mp3.ParseFrameHeader(data)
+
+	if err != nil || first.Size >= len(data) {
+		return OutputFormatLPCM
+	}
+
+	// a single valid frame header is too likely to occur in the raw samples
+	if second, err := mp3.ParseFrameHeader(data[first.Size:]); err == nil &&
+		second.Version == first.Version && second.Layer == first.Layer && second.SampleRate == first.SampleRate {
+		return OutputFormatMp3
+	}
+
+	return OutputFormatLPCM
+}
+
+// containerFormat detects the format by the magic bytes of the container
+func containerFormat(data []byte) (outputFormat, bool) {
+	switch {
+	case len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WAVE":
+		return OutputFormatWav, true
+	case len(data) >= 4 && string(data[:4]) == "OggS":
+		return OutputFormatOggOpus, true
+	case len(data) >= 3 && string(data[:3]) == "ID3":
+		return OutputFormatMp3, true
+	}
+
+	return "", false
+}
+
+// probeAudio checks that the data is the audio of the declared format and sample rate
+// and returns its duration, the lpcm sample rate can not be detected and is taken as declared
+func probeAudio(data []byte, format outputFormat, sampleRate int) (audioInfo, error) {
+	var (
+		info audioInfo
+		err  error
+	)
+
+	if len(data) == 0 {
+		return info, ErrInvalidAudio
+	}
+
+	// the raw samples may look like anything, so the declared lpcm is rejected only for the containers
+	if _, ok := containerFormat(data); format == OutputFormatLPCM && ok {
+		return info, ErrAudioFormatMismatch
+	} else if format != OutputFormatLPCM && detectAudioFormat(data) != format {
+		return info, ErrAudioFormatMismatch
+	}
+
+	switch format {
+	case OutputFormatLPCM:
+		if sampleRate <= 0 || len(data)%pcmSampleSize != 0 {
+			return info, ErrInvalidAudio
+		}
+
+		info = audioInfo{sampleRate: sampleRate, duration: pcmDuration(len(data), sampleRate, 1)}
+	case OutputFormatWav:
+		info, err = probeWAV(data)
+	case OutputFormatOggOpus:
+		info, err = probeOggOpus(data)
+	case OutputFormatMp3:
+		info, err = probeMP3(data)
+	default:
+		return info, ErrInvalidOutputFormat
+	}
+
+	if err != nil {
+		return info, err
+	} else if sampleRate != 0 && info.sampleRate != sampleRate {
+		return info, ErrAudioSampleRateMismatch
+	}
+
+	info.format = format
+
+	return info, nil
+}
+
+func probeWAV(data []byte) (audioInfo, error) {
+	samples, sampleRate, channels, err := parseWAV(data)
+
+	if err != nil {
+		return audioInfo{}, err
+	}
+
+	return audioInfo{sampleRate: sampleRate, duration: pcmDuration(len(samples), sampleRate, channels)}, nil
+}
+
+// parseWAV returns the samples of the data chunk with the sample rate and the number of channels
+func parseWAV(data []byte) ([]byte, int, int, error) {
+	var (
+		sampleRate int
+		channels   int
+	)
+
+	if len(data) < 12 {
+		return nil, 0, 0, ErrInvalidAudio
+	}
+
+	for chunks := data[12:]; len(chunks) >= 8; {
+		id, size := string(chunks[:4]), int(binary.LittleEndian.Uint32(chunks[4:8]))
+		chunks = chunks[8:]
+
+		if size > len(chunks) || size < 0 {
+			size = len(chunks)
+		}
+
+		switch id {
+		case "fmt ":
+			// only the 16-bit integer pcm is supported
+			if size < 16 || binary.LittleEndian.Uint16(chunks[:2]) != wavFormatPCM ||
+				binary.LittleEndian.Uint16(chunks[14:16]) != 16 {
+				return nil, 0, 0, ErrInvalidAudio
+			}
+
+			channels = int(binary.LittleEndian.Uint16(chunks[2:4]))
+			sampleRate = int(binary.LittleEndian.Uint32(chunks[4:8]))
+		case "data":
+			if channels <= 0 || sampleRate <= 0 {
+				return nil, 0, 0, ErrInvalidAudio
+			}
+
+			return chunks[:size-size%(pcmSampleSize*channels)], sampleRate, channels, nil
+		}
+
+		// chunks are word aligned
+		if size+size%2 > len(chunks) {
+			break
+		}
+
+		chunks = chunks[size+size%2:]
+	}
+
+	return nil, 0, 0, ErrInvalidAudio
+}
+
+func probeOggOpus(data []byte) (audioInfo, error) {
+	info, err := oggopus.Probe(bytes.NewReader(data))
+
+	if err != nil {
+		return audioInfo{}, ErrInvalidAudio
+	}
+
+	return audioInfo{sampleRate: oggopus.SampleRate, duration: info.Duration}, nil
+}
+
+func probeMP3(data []byte) (audioInfo, error) {
+	info, err := mp3.Probe(bytes.NewReader(data))
+
+	if err != nil {
+		return audioInfo{}, ErrInvalidAudio
+	}
+
+	return audioInfo{sampleRate: info.Header.SampleRate, duration: info.Duration}, nil
+}
+
+func pcmDuration(size, sampleRate, channels int) time.Duration {
+	return time.Duration(int64(size) * int64(time.Second) / int64(pcmSampleSize*sampleRate*channels))
+}
@@ -0,0 +1,187 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/audio/oggopus"
+	"testing"
+	"time"
+)
+
+// testWAV returns the 16-bit mono wav with the given number of samples
+func testWAV(sampleRate, samples int) []byte {
+	return append(audio.PCMFormat(sampleRate).Header(uint32(samples*2)), make([]byte, samples*2)...)
+}
+
+// testFloatWAV returns the wav with the IEEE float format tag
+func testFloatWAV() []byte {
+	data := testWAV(16000, 10)
+	binary.LittleEndian.PutUint16(data[20:], 3)
+
+	return data
+}
+
+// testOggOpus is the mono ogg opus stream of the second of silence
+var testOggOpus = func() []byte {
+	var buf bytes.Buffer
+
+	_ = oggopus.Silence(&buf, 1, time.Second)
+
+	return buf.Bytes()
+}()
+
+// testMP3 returns the MPEG-1 layer III 128 kbit/s 44.1 kHz stream with the given number of frames
+func testMP3(frames int) []byte {
+	var data []byte
+
+	for i := 0; i < frames; i++ {
+		frame := make([]byte, 417)
+		copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+		data = append(data, frame...)
+	}
+
+	return data
+}
+
+func TestDetectAudioFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []byte
+		want outputFormat
+	}{
+		{name: "wav", in: testWAV(8000, 10), want: OutputFormatWav},
+		{name: "ogg opus", in: testOggOpus, want: OutputFormatOggOpus},
+		{name: "mp3 frames", in: testMP3(2), want: OutputFormatMp3},
+		{name: "single mp3 frame", in: testMP3(1), want: OutputFormatLPCM},
+		{name: "pcm starting like mp3 frame", in: append([]byte{0xff, 0xfb, 0x90, 0x64}, make([]byte, 1000)...), want: OutputFormatLPCM},
+		{name: "mp3 with id3", in: append([]byte("ID3\x04\x00\x00\x00\x00\x00\x00"), testMP3(1)...), want: OutputFormatMp3},
+		{name: "raw pcm", in: []byte{0x01, 0x00, 0x02, 0x00}, want: OutputFormatLPCM},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			if got := detectAudioFormat(entry.in); got != entry.want {
+				t.Errorf("got %s, want %s", got, entry.want)
+			}
+		})
+	}
+}
+
+func TestProbeAudio(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         []byte
+		format     outputFormat
+		sampleRate int
+		want       audioInfo
+		err        error
+	}{
+		{
+			name:   "wav",
+			in:     testWAV(16000, 8000),
+			format: OutputFormatWav,
+			want:   audioInfo{format: OutputFormatWav, sampleRate: 16000, duration: 500 * time.Millisecond},
+		},
+		{
+			name:       "lpcm",
+			in:         make([]byte, 16000),
+			format:     OutputFormatLPCM,
+			sampleRate: 8000,
+			want:       audioInfo{format: OutputFormatLPCM, sampleRate: 8000, duration: time.Second},
+		},
+		{
+			name:       "lpcm looking like mp3",
+			in:         testMP3(4),
+			format:     OutputFormatLPCM,
+			sampleRate: 8000,
+			want:       audioInfo{format: OutputFormatLPCM, sampleRate: 8000, duration: 4 * 417 * time.Second / 16000},
+		},
+		{
+			name:       "lpcm in wav container",
+			in:         testWAV(8000, 10),
+			format:     OutputFormatLPCM,
+			sampleRate: 8000,
+			err:        ErrAudioFormatMismatch,
+		},
+		{
+			name:   "float wav",
+			in:     testFloatWAV(),
+			format: OutputFormatWav,
+			err:    ErrInvalidAudio,
+		},
+		{
+			name:   "ogg opus",
+			in:     testOggOpus,
+			format: OutputFormatOggOpus,
+			want:   audioInfo{format: OutputFormatOggOpus, sampleRate: 48000, duration: time.Second},
+		},
+		{
+			name:   "mp3",
+			in:     append([]byte("ID3\x04\x00\x00\x00\x00\x00\x00"), testMP3(25)...),
+			format: OutputFormatMp3,
+			want:   audioInfo{format: OutputFormatMp3, sampleRate: 44100, duration: 25 * 1152 * time.Second / 44100},
+		},
+		{
+			name:   "declared format does not match",
+			in:     testWAV(16000, 10),
+			format: OutputFormatOggOpus,
+			err:    ErrAudioFormatMismatch,
+		},
+		{
+			name:       "declared sample rate does not match",
+			in:         testWAV(16000, 10),
+			format:     OutputFormatWav,
+			sampleRate: 8000,
+			err:        ErrAudioSampleRateMismatch,
+		},
+		{
+			name:   "lpcm without sample rate",
+			in:     make([]byte, 10),
+			format: OutputFormatLPCM,
+			err:    ErrInvalidAudio,
+		},
+		{
+			name:   "empty audio",
+			format: OutputFormatWav,
+			err:    ErrInvalidAudio,
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			got, err := probeAudio(entry.in, entry.format, entry.sampleRate)
+
+			if !errors.Is(err, entry.err) {
+				t.Errorf("got error %v, want %v", err, entry.err)
+				t.FailNow()
+			}
+
+			if err == nil && got != entry.want {
+				t.Errorf("got %+v, want %+v", got, entry.want)
+			}
+		})
+	}
+}
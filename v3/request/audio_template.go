@@ -23,6 +23,7 @@ package request
 
 import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
+	"sort"
 	"time"
 )
 
@@ -49,12 +50,12 @@ func (t audioTemplate) TextTemplate() *tts.UtteranceSynthesisRequest_TextTemplat
 		},
 	}
 
-	for key, value := range t.textVariables {
+	for _, key := range sortedKeys(t.textVariables) {
 		utterance.TextTemplate.Variables = append(
 			utterance.TextTemplate.Variables,
 			&tts.TextVariable{
 				VariableName:  key,
-				VariableValue: value,
+				VariableValue: t.textVariables[key],
 			},
 		)
 	}
@@ -79,7 +80,8 @@ func (t audioTemplate) Hints() ([]*tts.Hints, error) {
 		},
 	}
 
-	for key, value := range t.defaultVariables {
+	for _, key := range t.audioVariableNames() {
+		value := t.defaultVariables[key]
 		hint.AudioTemplate.TextTemplate.Variables = append(
 			hint.AudioTemplate.TextTemplate.Variables,
 			&tts.TextVariable{
@@ -106,3 +108,16 @@ func (t audioTemplate) Hints() ([]*tts.Hints, error) {
 
 	return []*tts.Hints{{Hint: &hint}}, err
 }
+
+// audioVariableNames returns the sorted names of the audio variables
+func (t audioTemplate) audioVariableNames() []string {
+	names := make([]string, 0, len(t.defaultVariables))
+
+	for name := range t.defaultVariables {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,178 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrAudioVariableRange = errors.New("audio variable out of range")
+)
+
+// AudioTemplateBuilder builds the AudioTemplateEntity validated up front
+type AudioTemplateBuilder struct {
+	template       audioTemplate
+	textVariables  map[string]string
+	audioVariables map[string]AudioVariable
+	audioErr       error
+}
+
+// NewAudioTemplateBuilder starts the audio template with the {placeholder} text template
+func NewAudioTemplateBuilder(textTemplate string) *AudioTemplateBuilder {
+	return &AudioTemplateBuilder{
+		template:       audioTemplate{textTemplate: textTemplate},
+		textVariables:  map[string]string{},
+		audioVariables: map[string]AudioVariable{},
+	}
+}
+
+// Audio sets the reference audio, zero sample rate is taken from the wav, ogg and mp3 headers
+func (b *AudioTemplateBuilder) Audio(source []byte, format outputFormat, sampleRate int) *AudioTemplateBuilder {
+	b.template.audioSource = source
+	b.template.audioFormat = format
+	b.template.audioSampleRate = sampleRate
+	b.audioErr = nil
+
+	return b
+}
+
+// TextVariable sets the value to synthesize in place of the variable
+func (b *AudioTemplateBuilder) TextVariable(name, value string) *AudioTemplateBuilder {
+	b.textVariables[bracedName(name)] = value
+
+	return b
+}
+
+// AudioVariable sets the text and the position of the variable in the reference audio
+func (b *AudioTemplateBuilder) AudioVariable(name string, variable AudioVariable) *AudioTemplateBuilder {
+	b.audioVariables[bracedName(name)] = variable
+
+	return b
+}
+
+// Variable sets both the value to synthesize and the variable of the reference audio
+func (b *AudioTemplateBuilder) Variable(name, value string, variable AudioVariable) *AudioTemplateBuilder {
+	return b.TextVariable(name, value).AudioVariable(name, variable)
+}
+
+// Build validates the template and returns the entity, all problems are returned as ValidationError
+func (b *AudioTemplateBuilder) Build() (AudioTemplateEntity, error) {
+	t := b.template
+	t.textVariables = make(map[string]string, len(b.textVariables))
+	t.defaultVariables = make(map[string]AudioVariable, len(b.audioVariables))
+
+	for name, value := range b.textVariables {
+		t.textVariables[name] = value
+	}
+
+	for name, variable := range b.audioVariables {
+		t.defaultVariables[name] = variable
+	}
+
+	if t.audioSampleRate == 0 && t.audioFormat != OutputFormatLPCM {
+		if info, err := probeAudio(t.audioSource, t.audioFormat, 0); err == nil {
+			t.audioSampleRate = info.sampleRate
+		}
+	}
+
+	if err := t.problems(b.audioErr).orNil(); err != nil {
+		return AudioTemplateEntity{}, err
+	}
+
+	return AudioTemplateEntity{audioTemplate: t}, nil
+}
+
+// Validate checks the variables against the template and the reference audio
+func (e AudioTemplateEntity) Validate() error {
+	return e.audioTemplate.validate()
+}
+
+func (t audioTemplate) validate() error {
+	return t.problems(nil).orNil()
+}
+
+// problems returns all problems of the template, audioErr is reported instead of probing the reference audio
+func (t audioTemplate) problems(audioErr error) ValidationError {
+	var (
+		problems ValidationError
+		info     audioInfo
+	)
+
+	if t.textTemplate == "" {
+		problems = append(problems, ErrEmptyTextEntry)
+	}
+
+	audioNames := t.audioVariableNames()
+
+	if err := checkTemplateNames(t.textTemplate, sortedKeys(t.textVariables)); err != nil {
+		problems = append(problems, fmt.Errorf("text variables: %w", err))
+	}
+
+	if err := checkTemplateNames(t.textTemplate, audioNames); err != nil {
+		problems = append(problems, fmt.Errorf("audio variables: %w", err))
+	}
+
+	if audioErr == nil {
+		info, audioErr = probeAudio(t.audioSource, t.audioFormat, t.audioSampleRate)
+	}
+
+	if audioErr != nil {
+		return append(problems, fmt.Errorf("reference audio: %w", audioErr))
+	}
+
+	return append(problems, t.checkAudioVariables(audioNames, info)...)
+}
+
+// checkAudioVariables checks that the variables lie inside the reference audio and do not overlap
+func (t audioTemplate) checkAudioVariables(names []string, info audioInfo) ValidationError {
+	var problems ValidationError
+
+	sort.Slice(names, func(i, j int) bool {
+		return t.defaultVariables[names[i]].Start < t.defaultVariables[names[j]].Start
+	})
+
+	for i, name := range names {
+		variable := t.defaultVariables[name]
+
+		switch {
+		case variable.Start < 0 || variable.Length <= 0 || variable.Start+variable.Length > info.duration:
+			problems = append(problems, fmt.Errorf(
+				"%w: %s [%s, %s] is outside of the %s audio",
+				ErrAudioVariableRange, name, variable.Start, variable.Start+variable.Length, info.duration,
+			))
+		case i > 0 && t.defaultVariables[names[i-1]].Start+t.defaultVariables[names[i-1]].Length > variable.Start:
+			problems = append(problems, fmt.Errorf(
+				"%w: %s overlaps %s", ErrAudioVariableRange, name, names[i-1],
+			))
+		}
+
+		if strings.TrimSpace(variable.Value) == "" {
+			problems = append(problems, fmt.Errorf("%w: %s has no text", ErrTemplateVariables, name))
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,144 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAudioTemplateBuilder_Build(t *testing.T) {
+	entity, err := NewAudioTemplateBuilder("Здравствуйте, {name}! Ваш заказ готов.").
+		Audio(testWAV(16000, 32000), OutputFormatWav, 0).
+		Variable("name", "Алексей", AudioVariable{Value: "Мария", Start: 700 * time.Millisecond, Length: 500 * time.Millisecond}).
+		Build()
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if entity.audioSampleRate != 16000 {
+		t.Errorf("sample rate must be taken from the wav header, got %d", entity.audioSampleRate)
+	}
+
+	if entity.textVariables["{name}"] != "Алексей" || entity.defaultVariables["{name}"].Value != "Мария" {
+		t.Errorf("variable names must be braced, got %v and %v", entity.textVariables, entity.defaultVariables)
+	}
+
+	r := NewRequest()
+	r.OutputFormat = OutputFormatOggOpus
+
+	if err = entity.Process(r); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if _, err = r.Build(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAudioTemplateBuilder_BuildInvalid(t *testing.T) {
+	_, err := NewAudioTemplateBuilder("Ваш заказ {order} будет доставлен {date}.").
+		Audio(testWAV(16000, 16000), OutputFormatWav, 8000).
+		Variable("order", "42", AudioVariable{Value: "7", Start: 200 * time.Millisecond, Length: 300 * time.Millisecond}).
+		AudioVariable("date", AudioVariable{Value: "завтра", Start: 900 * time.Millisecond, Length: 300 * time.Millisecond}).
+		Build()
+
+	var problems ValidationError
+
+	if !errors.As(err, &problems) || len(problems) != 2 {
+		t.Errorf("all problems must be returned at once, got %v", err)
+		t.FailNow()
+	}
+
+	if !errors.Is(err, ErrTemplateVariables) || !errors.Is(err, ErrAudioSampleRateMismatch) {
+		t.Errorf("missing text variable and sample rate mismatch must be reported, got %v", err)
+	}
+}
+
+func TestAudioTemplateEntity_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables map[string]AudioVariable
+		err       error
+	}{
+		{
+			name: "variables inside the audio",
+			variables: map[string]AudioVariable{
+				"{order}": {Value: "7", Start: 0, Length: 300 * time.Millisecond},
+				"{date}":  {Value: "завтра", Start: 300 * time.Millisecond, Length: 700 * time.Millisecond},
+			},
+		},
+		{
+			name: "variable after the end of the audio",
+			variables: map[string]AudioVariable{
+				"{order}": {Value: "7", Start: 0, Length: 300 * time.Millisecond},
+				"{date}":  {Value: "завтра", Start: 900 * time.Millisecond, Length: 300 * time.Millisecond},
+			},
+			err: ErrAudioVariableRange,
+		},
+		{
+			name: "overlapping variables",
+			variables: map[string]AudioVariable{
+				"{order}": {Value: "7", Start: 0, Length: 500 * time.Millisecond},
+				"{date}":  {Value: "завтра", Start: 400 * time.Millisecond, Length: 300 * time.Millisecond},
+			},
+			err: ErrAudioVariableRange,
+		},
+		{
+			name: "variable without the audio span",
+			variables: map[string]AudioVariable{
+				"{order}": {Value: "7", Start: 0, Length: 300 * time.Millisecond},
+			},
+			err: ErrTemplateVariables,
+		},
+		{
+			name: "variable named with and without the braces",
+			variables: map[string]AudioVariable{
+				"{order}": {Value: "7", Start: 0, Length: 300 * time.Millisecond},
+				"order":   {Value: "8", Start: 300 * time.Millisecond, Length: 300 * time.Millisecond},
+				"{date}":  {Value: "завтра", Start: 600 * time.Millisecond, Length: 300 * time.Millisecond},
+			},
+			err: ErrTemplateVariables,
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			err := NewAudioTemplateEntity(
+				"Ваш заказ {order} будет доставлен {date}.",
+				map[string]string{"{order}": "42", "{date}": "сегодня"},
+				entry.variables,
+				make([]byte, 16000),
+				OutputFormatLPCM,
+				8000,
+			).Validate()
+
+			if !errors.Is(err, entry.err) || (entry.err == nil) != (err == nil) {
+				t.Errorf("got %v, want %v", err, entry.err)
+			}
+		})
+	}
+}
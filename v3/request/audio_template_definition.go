@@ -0,0 +1,274 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnknownAudioTemplate = errors.New("unknown audio template")
+	ErrInvalidAudioTemplate = errors.New("invalid audio template definition")
+)
+
+type (
+	// AudioTemplateDefinition is the JSON/YAML form of the AudioTemplateEntity
+	AudioTemplateDefinition struct {
+		// Name is the registry name, the file name without the extension is used when empty
+		Name      string                             `json:"name,omitempty" yaml:"name,omitempty"`
+		Template  string                             `json:"template" yaml:"template"`
+		Variables map[string]AudioVariableDefinition `json:"variables" yaml:"variables"`
+		Audio     AudioDefinition                    `json:"audio" yaml:"audio"`
+	}
+
+	// AudioVariableDefinition describes the template variable
+	AudioVariableDefinition struct {
+		// Text is synthesized in place of the variable unless it is set on the call
+		Text string `json:"text,omitempty" yaml:"text,omitempty"`
+		// Reference is the text spoken in the reference audio
+		Reference string `json:"reference" yaml:"reference"`
+		StartMs   int64  `json:"start_ms" yaml:"start_ms"`
+		LengthMs  int64  `json:"length_ms" yaml:"length_ms"`
+	}
+
+	// AudioDefinition references the audio by the file path or embeds it as base64
+	AudioDefinition struct {
+		// Path is relative to the definition file
+		Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+		Content string `json:"content,omitempty" yaml:"content,omitempty"`
+		// Format and SampleRate are required for the headerless lpcm only
+		Format     outputFormat `json:"format,omitempty" yaml:"format,omitempty"`
+		SampleRate int          `json:"sample_rate,omitempty" yaml:"sample_rate,omitempty"`
+	}
+
+	// AudioTemplateRegistry keeps the audio templates by name
+	AudioTemplateRegistry struct {
+		templates map[string]AudioTemplateEntity
+	}
+)
+
+// ParseAudioTemplateDefinition parses the YAML or JSON definition
+func ParseAudioTemplateDefinition(data []byte) (AudioTemplateDefinition, error) {
+	var definition AudioTemplateDefinition
+
+	if err := yaml.Unmarshal(data, &definition); err != nil {
+		return definition, fmt.Errorf("%w: %s", ErrInvalidAudioTemplate, err)
+	}
+
+	return definition, nil
+}
+
+// Definition returns the serializable definition with the embedded audio
+func (e AudioTemplateEntity) Definition(name string) AudioTemplateDefinition {
+	definition := AudioTemplateDefinition{
+		Name:      name,
+		Template:  e.textTemplate,
+		Variables: make(map[string]AudioVariableDefinition, len(e.defaultVariables)),
+		Audio: AudioDefinition{
+			Content:    base64.StdEncoding.EncodeToString(e.audioSource),
+			Format:     e.audioFormat,
+			SampleRate: e.audioSampleRate,
+		},
+	}
+
+	for name, variable := range e.defaultVariables {
+		definition.Variables[strings.Trim(name, "{}")] = AudioVariableDefinition{
+			Text:      e.textVariables[name],
+			Reference: variable.Value,
+			StartMs:   variable.Start.Milliseconds(),
+			LengthMs:  variable.Length.Milliseconds(),
+		}
+	}
+
+	return definition
+}
+
+// Entity builds and validates the audio template, relative audio path is resolved against the working directory
+func (d AudioTemplateDefinition) Entity() (AudioTemplateEntity, error) {
+	b := NewAudioTemplateBuilder(d.Template)
+
+	for name, variable := range d.Variables {
+		b.Variable(name, variable.Text, AudioVariable{
+			Value:  variable.Reference,
+			Start:  time.Duration(variable.StartMs) * time.Millisecond,
+			Length: time.Duration(variable.LengthMs) * time.Millisecond,
+		})
+	}
+
+	data, err := d.Audio.read()
+
+	switch {
+	case err != nil:
+		b.audioErr = err
+	case d.Audio.Format != "" && d.Audio.Format != OutputFormatWav:
+		b.Audio(data, d.Audio.Format, d.Audio.SampleRate)
+	default:
+		b.ReadAudio(bytes.NewReader(data))
+	}
+
+	return b.Build()
+}
+
+func (d AudioDefinition) read() ([]byte, error) {
+	switch {
+	case (d.Path == "") == (d.Content == ""):
+		return nil, fmt.Errorf("%w: either audio path or content must be set", ErrInvalidAudioTemplate)
+	case d.Path != "":
+		return ioutil.ReadFile(d.Path)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(d.Content)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAudioTemplate, err)
+	}
+
+	return data, nil
+}
+
+// NewAudioTemplateRegistry creates the registry of the templates built from the definitions
+func NewAudioTemplateRegistry(definitions ...AudioTemplateDefinition) (*AudioTemplateRegistry, error) {
+	r := &AudioTemplateRegistry{templates: make(map[string]AudioTemplateEntity, len(definitions))}
+
+	for _, definition := range definitions {
+		if err := r.add(definition); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// LoadAudioTemplateRegistry loads the .json, .yaml and .yml definitions of the directory
+func LoadAudioTemplateRegistry(dir string) (*AudioTemplateRegistry, error) {
+	files, err := ioutil.ReadDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := &AudioTemplateRegistry{templates: make(map[string]AudioTemplateEntity, len(files))}
+
+	for _, file := range files {
+		ext := strings.ToLower(filepath.Ext(file.Name()))
+
+		if file.IsDir() || (ext != ".json" && ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+		data, err := ioutil.ReadFile(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		definition, err := ParseAudioTemplateDefinition(data)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		if definition.Name == "" {
+			definition.Name = strings.TrimSuffix(file.Name(), filepath.Ext(file.Name()))
+		}
+
+		if definition.Audio.Path != "" && !filepath.IsAbs(definition.Audio.Path) {
+			definition.Audio.Path = filepath.Join(dir, definition.Audio.Path)
+		}
+
+		if err = r.add(definition); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return r, nil
+}
+
+func (r *AudioTemplateRegistry) add(definition AudioTemplateDefinition) error {
+	if definition.Name == "" {
+		return fmt.Errorf("%w: name is empty", ErrInvalidAudioTemplate)
+	} else if _, ok := r.templates[definition.Name]; ok {
+		return fmt.Errorf("%w: duplicate name %s", ErrInvalidAudioTemplate, definition.Name)
+	}
+
+	entity, err := definition.Entity()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", definition.Name, err)
+	}
+
+	r.templates[definition.Name] = entity
+
+	return nil
+}
+
+// Names returns the sorted names of the templates
+func (r *AudioTemplateRegistry) Names() []string {
+	names := make([]string, 0, len(r.templates))
+
+	for name := range r.templates {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Entity returns the template with the text variables overriding the definition ones
+func (r *AudioTemplateRegistry) Entity(name string, textVariables map[string]string) (AudioTemplateEntity, error) {
+	entity, ok := r.templates[name]
+
+	if !ok {
+		return AudioTemplateEntity{}, fmt.Errorf("%w: %s", ErrUnknownAudioTemplate, name)
+	}
+
+	variables := make(map[string]string, len(entity.textVariables))
+
+	for key, value := range entity.textVariables {
+		variables[key] = value
+	}
+
+	for key, value := range textVariables {
+		key = bracedName(key)
+
+		if _, ok := variables[key]; !ok {
+			return AudioTemplateEntity{}, fmt.Errorf("%w: %s: unknown [%s]", ErrTemplateVariables, name, key)
+		}
+
+		variables[key] = value
+	}
+
+	entity.textVariables = variables
+
+	return entity, nil
+}
@@ -0,0 +1,151 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoadAudioTemplateRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "yatts")
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	files := map[string]string{
+		"greeting.wav": string(testWAV(8000, 16000)),
+		"greeting.yaml": `
+template: "Здравствуйте, {name}!"
+variables:
+  name:
+    text: клиент
+    reference: Мария
+    start_ms: 500
+    length_ms: 500
+audio:
+  path: greeting.wav
+`,
+		"order.json": `{
+  "name": "order-ready",
+  "template": "Ваш заказ {order} готов.",
+  "variables": {"order": {"text": "1", "reference": "7", "start_ms": 0, "length_ms": 300}},
+  "audio": {"content": "` + base64.StdEncoding.EncodeToString(make([]byte, 16000)) + `", "format": "lpcm", "sample_rate": 8000}
+}`,
+		"readme.txt": "not a template",
+	}
+
+	for name, content := range files {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+	}
+
+	registry, err := LoadAudioTemplateRegistry(dir)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if names := registry.Names(); !reflect.DeepEqual(names, []string{"greeting", "order-ready"}) {
+		t.Errorf("got names %v", names)
+	}
+
+	entity, err := registry.Entity("greeting", map[string]string{"name": "Алексей"})
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if entity.textVariables["{name}"] != "Алексей" || entity.audioFormat != OutputFormatLPCM || entity.audioSampleRate != 8000 {
+		t.Errorf("got %v %s %d", entity.textVariables, entity.audioFormat, entity.audioSampleRate)
+	}
+
+	if entity, _ = registry.Entity("order-ready", nil); entity.textVariables["{order}"] != "1" {
+		t.Errorf("definition text must be used by default, got %v", entity.textVariables)
+	}
+
+	if _, err = registry.Entity("order-ready", map[string]string{"date": "завтра"}); !errors.Is(err, ErrTemplateVariables) {
+		t.Errorf("unknown variable must be rejected, got %v", err)
+	}
+
+	if _, err = registry.Entity("missing", nil); !errors.Is(err, ErrUnknownAudioTemplate) {
+		t.Errorf("unknown template must be rejected, got %v", err)
+	}
+}
+
+func TestAudioTemplateDefinition(t *testing.T) {
+	entity, err := NewAudioTemplateBuilder("Ваш заказ {order} готов.").
+		Audio(make([]byte, 16000), OutputFormatLPCM, 8000).
+		Variable("order", "42", AudioVariable{Value: "7", Start: 100 * time.Millisecond, Length: 300 * time.Millisecond}).
+		Build()
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	data, err := json.Marshal(entity.Definition("order"))
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	definition, err := ParseAudioTemplateDefinition(data)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	got, err := definition.Entity()
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if !reflect.DeepEqual(got, entity) {
+		t.Errorf("got %+v, want %+v", got.Definition("order"), definition)
+	}
+
+	definition.Audio.Path = "reference.wav"
+
+	if _, err = definition.Entity(); !errors.Is(err, ErrInvalidAudioTemplate) {
+		t.Errorf("audio with both path and content must be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,280 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"strings"
+)
+
+// describeTextLength is the number of runes of the text shown by Describe
+const describeTextLength = 64
+
+type (
+	requestJSON struct {
+		Text                  string                `json:"text,omitempty"`
+		TextTemplate          *templateJSON         `json:"text_template,omitempty"`
+		AudioTemplate         *templateJSON         `json:"audio_template,omitempty"`
+		Voice                 string                `json:"voice,omitempty"`
+		Language              lang                  `json:"lang,omitempty"`
+		Speed                 float64               `json:"speed,omitempty"`
+		Emotion               string                `json:"emotion,omitempty"`
+		PitchShift            float64               `json:"pitch_shift,omitempty"`
+		SampleRate            int                   `json:"sample_rate,omitempty"`
+		OutputFormat          outputFormat          `json:"format,omitempty"`
+		LoudnessNormalization loudnessNormalization `json:"loudness_normalization,omitempty"`
+		Volume                float64               `json:"volume,omitempty"`
+		Model                 string                `json:"model,omitempty"`
+		UnsafeMode            bool                  `json:"unsafe_mode,omitempty"`
+	}
+
+	templateJSON struct {
+		Template        string                   `json:"template"`
+		Variables       map[string]string        `json:"variables,omitempty"`
+		AudioVariables  map[string]AudioVariable `json:"audio_variables,omitempty"`
+		AudioFormat     outputFormat             `json:"audio_format,omitempty"`
+		AudioSampleRate int                      `json:"audio_sample_rate,omitempty"`
+		AudioSize       int                      `json:"audio_size,omitempty"`
+	}
+)
+
+func (r *Request) GetText() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.Text
+}
+
+func (r *Request) GetVoice() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.Voice
+}
+
+func (r *Request) GetLanguage() lang {
+	if r == nil {
+		return ""
+	}
+
+	return r.Language
+}
+
+func (r *Request) GetSpeed() float64 {
+	if r == nil {
+		return 0
+	}
+
+	return r.Speed
+}
+
+func (r *Request) GetEmotion() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.Emotion
+}
+
+func (r *Request) GetPitchShift() float64 {
+	if r == nil {
+		return 0
+	}
+
+	return r.PitchShift
+}
+
+func (r *Request) GetSampleRate() int {
+	if r == nil {
+		return 0
+	}
+
+	return r.SampleRate
+}
+
+func (r *Request) GetOutputFormat() outputFormat {
+	if r == nil {
+		return ""
+	}
+
+	return r.OutputFormat
+}
+
+func (r *Request) GetLoudnessNormalization() loudnessNormalization {
+	if r == nil {
+		return ""
+	}
+
+	return r.LoudnessNormalization
+}
+
+func (r *Request) GetVolume() float64 {
+	if r == nil {
+		return 0
+	}
+
+	return r.Volume
+}
+
+func (r *Request) GetModel() string {
+	if r == nil {
+		return ""
+	}
+
+	return r.Model
+}
+
+func (r *Request) GetUnsafeMode() bool {
+	if r == nil {
+		return false
+	}
+
+	return r.UnsafeMode
+}
+
+// MarshalJSON encodes the options sent to the API, the reference audio is described by its size only
+func (r Request) MarshalJSON() ([]byte, error) {
+	result := requestJSON{
+		Text:                  r.Text,
+		Voice:                 r.Voice,
+		Language:              r.Language,
+		Speed:                 r.Speed,
+		Emotion:               r.Emotion,
+		PitchShift:            r.PitchShift,
+		SampleRate:            r.SampleRate,
+		OutputFormat:          r.OutputFormat,
+		LoudnessNormalization: r.LoudnessNormalization,
+		Volume:                r.Volume,
+		Model:                 r.Model,
+		UnsafeMode:            r.UnsafeMode,
+	}
+
+	if r.TextTemplate != nil {
+		result.TextTemplate = &templateJSON{
+			Template:  r.TextTemplate.template,
+			Variables: r.TextTemplate.variables,
+		}
+	}
+
+	if t := r.AudioTemplate; t != nil {
+		result.AudioTemplate = &templateJSON{
+			Template:        t.textTemplate,
+			Variables:       t.textVariables,
+			AudioVariables:  t.defaultVariables,
+			AudioFormat:     t.audioFormat,
+			AudioSampleRate: t.audioSampleRate,
+			AudioSize:       len(t.audioSource),
+		}
+	}
+
+	return json.Marshal(result)
+}
+
+// Describe returns the one line description for the logs with the shortened text
+func (r Request) Describe() string {
+	var parts []string
+
+	add := func(key, value string) {
+		if value != "" && value != "0" && value != "false" {
+			parts = append(parts, key+"="+value)
+		}
+	}
+
+	add("voice", r.Voice)
+	add("lang", string(r.Language))
+	add("emotion", r.Emotion)
+	add("speed", strconv.FormatFloat(r.Speed, 'g', -1, 64))
+	add("pitchShift", strconv.FormatFloat(r.PitchShift, 'g', -1, 64))
+	add("format", string(r.OutputFormat))
+	add("sampleRate", strconv.Itoa(r.SampleRate))
+	add("loudness", string(r.LoudnessNormalization))
+	add("volume", strconv.FormatFloat(r.Volume, 'g', -1, 64))
+	add("model", r.Model)
+	add("unsafeMode", strconv.FormatBool(r.UnsafeMode))
+
+	switch {
+	case r.Text != "":
+		add("text", describeText(r.Text))
+	case r.TextTemplate != nil:
+		add("textTemplate", describeText(r.TextTemplate.template))
+	case r.AudioTemplate != nil:
+		add("audioTemplate", describeText(r.AudioTemplate.textTemplate))
+		add("audioSize", strconv.Itoa(len(r.AudioTemplate.audioSource)))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Canonical returns the deterministic protobuf encoding of the request built for the API,
+// the G.711 formats are built as the 8 kHz lpcm they are encoded from
+func (r Request) Canonical() ([]byte, error) {
+	req, err := r.sent().Build()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(req)
+}
+
+// CacheKey returns the hex SHA-256 of the canonical encoding, the name of the G.711 format
+// is hashed with it, so that the key differs from the key of the lpcm request
+func (r Request) CacheKey() (string, error) {
+	canonical, err := r.Canonical()
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	_, _ = hash.Write(canonical)
+
+	if r.sent().OutputFormat != r.OutputFormat {
+		_, _ = hash.Write([]byte(r.OutputFormat))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// sent returns the request as it is sent to the API, the G.711 formats are switched to the 8 kHz lpcm
+func (r Request) sent() Request {
+	if r.OutputFormat == OutputFormatULaw || r.OutputFormat == OutputFormatALaw {
+		r.OutputFormat, r.SampleRate = OutputFormatLPCM, int(OutputSampleRate8k)
+	}
+
+	return r
+}
+
+func describeText(text string) string {
+	if runes := []rune(text); len(runes) > describeTextLength {
+		text = string(runes[:describeTextLength]) + "…"
+	}
+
+	return fmt.Sprintf("%q", text)
+}
@@ -0,0 +1,159 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"encoding/json"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"testing"
+)
+
+func TestRequest_Getters(t *testing.T) {
+	var r *Request
+
+	if r.GetVoice() != "" || r.GetVolume() != 0 || r.GetUnsafeMode() {
+		t.Error("nil request getters must return zero values")
+	}
+
+	r = &Request{Voice: "alena", Language: LangRu, PitchShift: 100, Model: "custom", UnsafeMode: true}
+
+	if r.GetVoice() != "alena" || r.GetLanguage() != LangRu || r.GetPitchShift() != 100 ||
+		r.GetModel() != "custom" || !r.GetUnsafeMode() {
+		t.Errorf("got %+v", r)
+	}
+}
+
+func TestRequest_Describe(t *testing.T) {
+	r := Request{
+		Text:         strings.Repeat("а", 70),
+		Voice:        "alena",
+		Emotion:      "good",
+		OutputFormat: OutputFormatLPCM,
+		SampleRate:   8000,
+		Volume:       -19,
+	}
+
+	want := `voice=alena emotion=good format=lpcm sampleRate=8000 volume=-19 text="` + strings.Repeat("а", 64) + `…"`
+
+	if got := r.Describe(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	r.Text = ""
+	r.AudioTemplate = &audioTemplate{
+		textTemplate:    "hello {name}",
+		textVariables:   map[string]string{"{name}": "Алексей"},
+		audioSource:     make([]byte, 100),
+		audioFormat:     OutputFormatLPCM,
+		audioSampleRate: 8000,
+	}
+
+	data, err := json.Marshal(r)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	want = `{"audio_template":{"template":"hello {name}","variables":{"{name}":"Алексей"},"audio_format":"lpcm",` +
+		`"audio_sample_rate":8000,"audio_size":100},"voice":"alena","emotion":"good","sample_rate":8000,"format":"lpcm","volume":-19}`
+
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+}
+
+func TestRequest_CacheKey(t *testing.T) {
+	build := func(variables map[string]AudioVariable) Request {
+		r := Request{Voice: "alena", OutputFormat: OutputFormatOggOpus}
+		_ = NewAudioTemplateEntity(
+			"{a} {b} {c}",
+			map[string]string{"{a}": "1", "{b}": "2", "{c}": "3"},
+			variables,
+			make([]byte, 16000),
+			OutputFormatLPCM,
+			8000,
+		).Process(&r)
+
+		return r
+	}
+
+	variables := map[string]AudioVariable{
+		"{a}": {Value: "4", Length: 100},
+		"{b}": {Value: "5", Start: 100, Length: 100},
+		"{c}": {Value: "6", Start: 200, Length: 100},
+	}
+
+	first, err := build(variables).CacheKey()
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	for i := 0; i < 10; i++ {
+		if key, _ := build(variables).CacheKey(); key != first {
+			t.Error("cache key must not depend on the map order")
+			t.FailNow()
+		}
+	}
+
+	r := build(variables)
+	r.Volume = -20
+
+	if key, _ := r.CacheKey(); key == first {
+		t.Error("cache key must depend on the options")
+	}
+
+	if _, err = (Request{}).CacheKey(); err == nil {
+		t.Error("request without the utterance must not have the cache key")
+	}
+}
+
+func TestRequest_CanonicalG711(t *testing.T) {
+	lpcm := Request{Text: "Привет", Voice: "alena", OutputFormat: OutputFormatLPCM, SampleRate: int(OutputSampleRate8k)}
+	ulaw := Request{Text: "Привет", Voice: "alena", OutputFormat: OutputFormatULaw}
+
+	canonical, err := ulaw.Canonical()
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	var req tts.UtteranceSynthesisRequest
+
+	if err = proto.Unmarshal(canonical, &req); err != nil {
+		t.Errorf("canonical encoding must be the protobuf message, got %v", err)
+	} else if req.OutputAudioSpec.GetRawAudio().GetSampleRateHertz() != 8000 {
+		t.Errorf("G.711 must be built as the 8 kHz lpcm, got %v", req.OutputAudioSpec)
+	}
+
+	lpcmKey, _ := lpcm.CacheKey()
+	ulawKey, _ := ulaw.CacheKey()
+
+	if lpcmKey == ulawKey {
+		t.Error("G.711 cache key must differ from the lpcm one")
+	}
+}
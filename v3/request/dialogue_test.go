@@ -0,0 +1,90 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDialogueEntity_Requests(t *testing.T) {
+	d := DialogueEntity{
+		Turns: []DialogueTurn{
+			{Speaker: "alice", Text: "Привет"},
+			{Speaker: "bob", Text: "Здравствуй", Options: []Option{Emotion(EmotionStrict)}},
+		},
+		Speakers: map[string][]Option{
+			"alice": {Voice(VoiceAlena), Emotion(EmotionGood)},
+			"bob":   {Voice(VoiceKirill), Emotion(EmotionGood)},
+		},
+	}
+
+	requests, err := d.Requests(OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate8k))
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if len(requests) != 2 || requests[0].Voice != "alena" || requests[1].Voice != "kirill" {
+		t.Error("every turn must use the speaker voice")
+		t.FailNow()
+	}
+
+	if requests[0].Emotion != "good" || requests[1].Emotion != "strict" {
+		t.Error("turn options must override the speaker options")
+		t.FailNow()
+	}
+
+	if _, err := d.Requests(OutputFormat(OutputFormatLPCM)); !errors.Is(err, ErrDialogueSampleRate) {
+		t.Error("sample rate must be required")
+		t.FailNow()
+	}
+
+	if _, err := d.Requests(OutputFormat(OutputFormatMp3)); !errors.Is(err, ErrDialogueFormat) {
+		t.Error("raw format must be required")
+		t.FailNow()
+	}
+
+	for _, format := range []outputFormat{OutputFormatULaw, OutputFormatOggOpus} {
+		if requests, err := d.Requests(OutputFormat(format)); err != nil || len(requests) != 2 {
+			t.Errorf("%s turns must be joined, got %v", format, err)
+			t.FailNow()
+		}
+	}
+
+	d.Turns[1].Options = append(d.Turns[1].Options, OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate8k))
+
+	if _, err := d.Requests(OutputFormat(OutputFormatALaw)); !errors.Is(err, ErrDialogueFormats) {
+		t.Error("turns of different formats must be rejected")
+		t.FailNow()
+	}
+
+	d.Turns[1].Options = []Option{Emotion(EmotionStrict)}
+
+	d.Turns = append(d.Turns, DialogueTurn{Speaker: "eve", Text: "..."})
+
+	if _, err := d.Requests(OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate8k)); !errors.Is(err, ErrUnknownSpeaker) {
+		t.Error("unknown speaker must be rejected")
+		t.FailNow()
+	}
+}
@@ -0,0 +1,251 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidLexiconEntry = errors.New("invalid lexicon entry")
+)
+
+type (
+	// LexiconEntry describes how a word or a pattern must be pronounced.
+	// Exactly one of Word and Pattern and exactly one of Replacement, Alias and Phoneme must be set.
+	LexiconEntry struct {
+		// Word is matched literally as a whole word
+		Word string `json:"word,omitempty"`
+		// Pattern is a regular expression matched on word boundaries
+		Pattern string `json:"pattern,omitempty"`
+		// CaseSensitive disables case folding
+		CaseSensitive bool `json:"case_sensitive,omitempty"`
+		// Replacement is the text to speak instead, it may contain "+" stress marks
+		// and $1-style references to the Pattern groups
+		Replacement string `json:"replacement,omitempty"`
+		// Alias is spoken instead of the word, it is the <sub> alias in the v1 SSML
+		Alias string `json:"alias,omitempty"`
+		// Phoneme is the transcription spoken through the TTS markup [[...]] phonemes
+		Phoneme string `json:"phoneme,omitempty"`
+	}
+
+	// Lexicon is a user pronunciation dictionary
+	Lexicon struct {
+		entries []lexiconEntry
+	}
+
+	lexiconEntry struct {
+		LexiconEntry
+		re *regexp.Regexp
+	}
+
+	lexiconMatch struct {
+		entry  *lexiconEntry
+		groups []int
+	}
+)
+
+// NewLexicon creates a lexicon from the entries, earlier entries win when matches overlap
+func NewLexicon(entries ...LexiconEntry) (*Lexicon, error) {
+	l := &Lexicon{entries: make([]lexiconEntry, 0, len(entries))}
+
+	for i, entry := range entries {
+		compiled, err := entry.compile()
+
+		if err != nil {
+			return nil, fmt.Errorf("%w #%d: %s", ErrInvalidLexiconEntry, i, err.Error())
+		}
+
+		l.entries = append(l.entries, lexiconEntry{LexiconEntry: entry, re: compiled})
+	}
+
+	return l, nil
+}
+
+// ReadLexicon reads a lexicon from the JSON array of entries
+func ReadLexicon(r io.Reader) (*Lexicon, error) {
+	var entries []LexiconEntry
+
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+
+	return NewLexicon(entries...)
+}
+
+// LoadLexicon reads a lexicon from the JSON file
+func LoadLexicon(path string) (*Lexicon, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	return ReadLexicon(f)
+}
+
+// Lexicons registers pronunciation lexicons, they are applied after the text processors
+func Lexicons(lexicons ...*Lexicon) Option {
+	return func(req *Request) error {
+		req.Lexicons = append(req.Lexicons, lexicons...)
+
+		return nil
+	}
+}
+
+func (e LexiconEntry) compile() (*regexp.Regexp, error) {
+	var pattern string
+
+	switch {
+	case (e.Word == "") == (e.Pattern == ""):
+		return nil, errors.New("exactly one of word and pattern must be set")
+	case countNonEmpty(e.Replacement, e.Alias, e.Phoneme) != 1:
+		return nil, errors.New("exactly one of replacement, alias and phoneme must be set")
+	case e.Word != "":
+		pattern = regexp.QuoteMeta(e.Word)
+	default:
+		pattern = e.Pattern
+	}
+
+	if !e.CaseSensitive {
+		pattern = "(?i)" + pattern
+	}
+
+	return regexp.Compile(pattern)
+}
+
+// matches returns the non-empty matches of the entry which lie on word boundaries
+func (e *lexiconEntry) matches(text string) [][]int {
+	var result [][]int
+
+	for _, loc := range e.re.FindAllStringSubmatchIndex(text, -1) {
+		if loc[1] > loc[0] && isWordBoundary(text, loc[0]) && isWordBoundary(text, loc[1]) {
+			result = append(result, loc)
+		}
+	}
+
+	return result
+}
+
+// expand substitutes the pattern groups into the template
+func (m lexiconMatch) expand(text, template string) string {
+	return string(m.entry.re.ExpandString(nil, template, text, m.groups))
+}
+
+// matchLexicons returns non-overlapping matches of all lexicons ordered by position,
+// every entry is matched once and the leftmost matches win
+func matchLexicons(lexicons []*Lexicon, text string) []lexiconMatch {
+	var (
+		candidates []lexiconMatch
+		result     []lexiconMatch
+		end        int
+	)
+
+	for _, lexicon := range lexicons {
+		for i := range lexicon.entries {
+			entry := &lexicon.entries[i]
+
+			for _, loc := range entry.matches(text) {
+				candidates = append(candidates, lexiconMatch{entry: entry, groups: loc})
+			}
+		}
+	}
+
+	// the stable sort keeps the earlier entries first among the matches at the same position
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].groups[0] < candidates[j].groups[0]
+	})
+
+	for _, m := range candidates {
+		if m.groups[0] >= end {
+			result = append(result, m)
+			end = m.groups[1]
+		}
+	}
+
+	return result
+}
+
+// renderLexicon replaces the matches in text with the TTS markup
+func renderLexicon(text string, matches []lexiconMatch) string {
+	var (
+		result strings.Builder
+		last   int
+	)
+
+	for _, m := range matches {
+		result.WriteString(text[last:m.groups[0]])
+
+		switch {
+		case m.entry.Phoneme != "":
+			result.WriteString("[[" + m.expand(text, m.entry.Phoneme) + "]]")
+		case m.entry.Alias != "":
+			result.WriteString(m.expand(text, m.entry.Alias))
+		default:
+			result.WriteString(m.expand(text, m.entry.Replacement))
+		}
+
+		last = m.groups[1]
+	}
+
+	result.WriteString(text[last:])
+
+	return result.String()
+}
+
+func isWordBoundary(text string, i int) bool {
+	if i == 0 || i == len(text) {
+		return true
+	}
+
+	before, _ := utf8.DecodeLastRuneInString(text[:i])
+	after, _ := utf8.DecodeRuneInString(text[i:])
+
+	return !isWordRune(before) || !isWordRune(after)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+func countNonEmpty(values ...string) int {
+	var count int
+
+	for _, value := range values {
+		if value != "" {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,131 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestNewLexicon(t *testing.T) {
+	if _, err := NewLexicon(LexiconEntry{Word: "ya", Replacement: "яндекс"}); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if _, err := NewLexicon(LexiconEntry{Word: "ya", Pattern: "ya", Replacement: "яндекс"}); !errors.Is(err, ErrInvalidLexiconEntry) {
+		t.Error("entry with both word and pattern must be rejected")
+		t.FailNow()
+	}
+
+	if _, err := NewLexicon(LexiconEntry{Word: "ya", Alias: "яндекс", Phoneme: "j a"}); !errors.Is(err, ErrInvalidLexiconEntry) {
+		t.Error("entry with both alias and phoneme must be rejected")
+		t.FailNow()
+	}
+}
+
+func TestLoadLexicon(t *testing.T) {
+	f, err := ioutil.TempFile("", "lexicon*.json")
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	_, _ = f.WriteString(`[{"word": "SpeechKit", "replacement": "спичк+ит"}]`)
+	_ = f.Close()
+
+	l, err := LoadLexicon(f.Name())
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if len(l.entries) != 1 {
+		t.Error("lexicon must contain 1 entry")
+		t.FailNow()
+	}
+}
+
+func TestLexicons(t *testing.T) {
+	l, err := NewLexicon(
+		LexiconEntry{Word: "SpeechKit", Replacement: "спичк+ит"},
+		LexiconEntry{Word: "ИП", CaseSensitive: true, Alias: "индивидуальный предприниматель"},
+		LexiconEntry{Pattern: `(\d+) ?ГБ`, Replacement: "$1 гигабайт"},
+		LexiconEntry{Word: "щи", Phoneme: "ɕ ɕ i"},
+	)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	tests := map[string]string{
+		"speechkit и SPEECHKIT, но не speechkits": "спичк+ит и спичк+ит, но не speechkits",
+		"ИП и ип, ИПОТЕКА":                        "индивидуальный предприниматель и ип, ИПОТЕКА",
+		"диск на 512ГБ":                           "диск на 512 гигабайт",
+		"Щи да каша":                              "[[ɕ ɕ i]] да каша",
+	}
+
+	for text, expected := range tests {
+		r := NewRequest()
+
+		if err := Lexicons(l)(r); err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		if err := (SimpleTextEntity{Text: text}).Process(r); err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		if r.Text != expected {
+			t.Errorf("text must be %q, got %q", expected, r.Text)
+		}
+	}
+}
+
+func TestLexicons_EmptyMatches(t *testing.T) {
+	l, err := NewLexicon(LexiconEntry{Pattern: "a*", Replacement: "x"})
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	for text, expected := range map[string]string{"b": "b", "b aa": "b x", "aa ba a": "x ba x"} {
+		r := NewRequest()
+		r.Lexicons = []*Lexicon{l}
+
+		if err := (SimpleTextEntity{Text: text}).Process(r); err != nil {
+			t.Error(err)
+		} else if r.Text != expected {
+			t.Errorf("text must be %q, got %q", expected, r.Text)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	markdownATXHeading    = regexp.MustCompile(`^ {0,3}(#{1,6})(?:\s+(.*?))?(?:\s+#+)?\s*$`)
+	markdownSetextHeading = regexp.MustCompile(`^ {0,3}(=+|-+)\s*$`)
+	markdownRule          = regexp.MustCompile(`^ {0,3}(?:(?:\*\s*){3,}|(?:-\s*){3,}|(?:_\s*){3,})$`)
+	markdownListItem      = regexp.MustCompile(`^(\s*)(?:([-*+])|(\d{1,9})[.)])(?:\s+(.*))?$`)
+	markdownFence         = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+	markdownReference     = regexp.MustCompile(`^ {0,3}\[[^\]]+\]:\s*\S+`)
+	markdownTableDivider  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(?:\|\s*:?-+:?\s*)*\|?\s*$`)
+
+	markdownCodeSpan       = regexp.MustCompile("(`+)(.+?)(`+)")
+	markdownImage          = regexp.MustCompile(`!\[([^\]]*)\](?:\([^)]*\)|\[[^\]]*\])`)
+	markdownLink           = regexp.MustCompile(`\[([^\]]*)\](?:\([^)]*\)|\[[^\]]*\])`)
+	markdownAutolink       = regexp.MustCompile(`<(?:https?|ftp|mailto):[^>\s]*>`)
+	markdownStrong         = regexp.MustCompile(`(\*\*|__)([^*_]+?)(\*\*|__)`)
+	markdownEmphasis       = regexp.MustCompile(`(^|[^\p{L}\p{N}\\])[*_]([^*_\s](?:[^*_]*[^*_\s])?)[*_]`)
+	markdownStrikethrough  = regexp.MustCompile(`~~([^~]+)~~`)
+	markdownEscape         = regexp.MustCompile(`\\([\\` + "`" + `*_{}\[\]()#+\-.!>|~])`)
+	markdownInlineHTMLTags = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	markdownSpaceBefore    = regexp.MustCompile(`\s+([.,;:!?])`)
+)
+
+type (
+	markdownParser struct {
+		config    markupConfig
+		blocks    []markupBlock
+		paragraph []string
+		// indents of the open lists
+		lists []markdownList
+		// the last block is a list item which may be continued by the following lines
+		inItem bool
+	}
+
+	markdownList struct {
+		indent int
+	}
+)
+
+// parseMarkdown converts the markdown document into the speakable blocks
+func parseMarkdown(src string, config markupConfig) []markupBlock {
+	p := markdownParser{config: config}
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.ReplaceAll(lines[i], "\t", "    ")
+		trimmed := strings.TrimSpace(line)
+
+		if m := markdownFence.FindStringSubmatch(line); m != nil {
+			i = p.code(lines, i+1, m[1])
+
+			continue
+		}
+
+		switch {
+		case trimmed == "":
+			p.flush()
+			p.inItem = false
+		case strings.HasPrefix(line, "    ") && len(p.paragraph) == 0 && !p.inItem && len(p.lists) == 0:
+			i = p.indentedCode(lines, i)
+		case markdownATXHeading.MatchString(line):
+			p.flush()
+			p.heading(markdownATXHeading.FindStringSubmatch(line)[2])
+		case len(p.paragraph) > 0 && !p.inItem && markdownSetextHeading.MatchString(line):
+			text := strings.Join(p.paragraph, " ")
+			p.paragraph = nil
+			p.heading(text)
+		case markdownRule.MatchString(line):
+			p.flush()
+			p.lists = nil
+			p.inItem = false
+		case markdownListItem.MatchString(line):
+			p.flush()
+			p.item(markdownListItem.FindStringSubmatch(line))
+		case p.inItem:
+			if !p.config.skipped(ElementList) {
+				last := &p.blocks[len(p.blocks)-1]
+				last.text += " " + p.inline(trimmed)
+			}
+		case strings.HasPrefix(trimmed, ">"):
+			p.flush()
+
+			if !p.config.skipped(ElementBlockquote) {
+				p.paragraph = append(p.paragraph, strings.TrimSpace(strings.TrimLeft(trimmed, "> ")))
+			}
+		case strings.HasPrefix(trimmed, "|"):
+			p.flush()
+			p.tableRow(trimmed)
+		case markdownReference.MatchString(line):
+		default:
+			p.lists = nil
+			p.paragraph = append(p.paragraph, trimmed)
+		}
+	}
+
+	p.flush()
+
+	return p.blocks
+}
+
+// flush turns the accumulated paragraph lines into a block
+func (p *markdownParser) flush() {
+	if len(p.paragraph) == 0 {
+		return
+	}
+
+	p.lists = nil
+	p.add(markupBlock{kind: blockParagraph, text: p.inline(strings.Join(p.paragraph, " "))})
+	p.paragraph = nil
+}
+
+func (p *markdownParser) add(block markupBlock) {
+	if block.text = collapseSpaces(block.text); block.text != "" {
+		p.blocks = append(p.blocks, block)
+	}
+}
+
+func (p *markdownParser) heading(text string) {
+	p.lists = nil
+	p.inItem = false
+
+	if !p.config.skipped(ElementHeading) {
+		p.add(markupBlock{kind: blockHeading, text: p.inline(text)})
+	}
+}
+
+func (p *markdownParser) item(m []string) {
+	indent := len(m[1])
+
+	for len(p.lists) > 0 && p.lists[len(p.lists)-1].indent > indent {
+		p.lists = p.lists[:len(p.lists)-1]
+	}
+
+	if len(p.lists) == 0 || p.lists[len(p.lists)-1].indent < indent {
+		p.lists = append(p.lists, markdownList{indent: indent})
+	}
+
+	// the bullet items are not numbered
+	number, _ := strconv.Atoi(m[3])
+
+	if p.inItem = true; !p.config.skipped(ElementList) {
+		// an empty item is kept, so that the following lines have something to continue
+		p.blocks = append(p.blocks, markupBlock{kind: blockListItem, text: p.inline(m[4]), number: number})
+	}
+}
+
+func (p *markdownParser) tableRow(row string) {
+	if p.config.skipped(ElementTable) || markdownTableDivider.MatchString(row) {
+		return
+	}
+
+	cells := strings.Split(strings.Trim(row, "| "), "|")
+
+	for i := range cells {
+		cells[i] = p.inline(cells[i])
+	}
+
+	p.add(markupBlock{kind: blockParagraph, text: strings.Join(cells, ", ")})
+}
+
+// code consumes the fenced code block and returns the index of its closing line
+func (p *markdownParser) code(lines []string, from int, fence string) int {
+	p.flush()
+	p.inItem = false
+
+	var (
+		code []string
+		i    = from
+	)
+
+	for ; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+			break
+		}
+
+		code = append(code, lines[i])
+	}
+
+	if !p.config.skipped(ElementCodeBlock) {
+		p.add(markupBlock{kind: blockParagraph, text: strings.Join(code, " ")})
+	}
+
+	return i
+}
+
+// indentedCode consumes the indented code block and returns the index of its last line
+func (p *markdownParser) indentedCode(lines []string, from int) int {
+	var (
+		code []string
+		i    = from
+	)
+
+	for ; i < len(lines); i++ {
+		line := strings.ReplaceAll(lines[i], "\t", "    ")
+
+		if !strings.HasPrefix(line, "    ") && strings.TrimSpace(line) != "" {
+			break
+		}
+
+		code = append(code, line)
+	}
+
+	if !p.config.skipped(ElementCodeBlock) {
+		p.add(markupBlock{kind: blockParagraph, text: strings.Join(code, " ")})
+	}
+
+	return i - 1
+}
+
+// inline strips the inline markdown markup from the text
+func (p *markdownParser) inline(text string) string {
+	var (
+		result strings.Builder
+		last   int
+	)
+
+	for _, loc := range markdownCodeSpan.FindAllStringSubmatchIndex(text, -1) {
+		result.WriteString(p.inlineText(text[last:loc[0]]))
+
+		if !p.config.skipped(ElementInlineCode) {
+			result.WriteString(strings.TrimSpace(text[loc[4]:loc[5]]))
+		}
+
+		last = loc[1]
+	}
+
+	result.WriteString(p.inlineText(text[last:]))
+
+	// removed images and links may leave a space before the punctuation
+	return markdownSpaceBefore.ReplaceAllString(collapseSpaces(result.String()), "$1")
+}
+
+func (p *markdownParser) inlineText(text string) string {
+	text = markdownImage.ReplaceAllStringFunc(text, func(image string) string {
+		if p.config.skipped(ElementImage) {
+			return ""
+		}
+
+		return markdownImage.FindStringSubmatch(image)[1]
+	})
+	text = markdownLink.ReplaceAllStringFunc(text, func(link string) string {
+		if p.config.skipped(ElementLink) {
+			return ""
+		}
+
+		return markdownLink.FindStringSubmatch(link)[1]
+	})
+	text = markdownAutolink.ReplaceAllString(text, "")
+	text = markdownInlineHTMLTags.ReplaceAllString(text, " ")
+	text = markdownStrong.ReplaceAllString(text, "$2")
+	text = markdownEmphasis.ReplaceAllString(text, "$1$2")
+	text = markdownStrikethrough.ReplaceAllString(text, "$1")
+	text = markdownEscape.ReplaceAllString(text, "$1")
+
+	return html.UnescapeString(text)
+}
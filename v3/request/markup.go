@@ -0,0 +1,200 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ttsMarkup matches the document text which would be spoken as the TTS markup: the pauses
+// sil<[...]> and <[...]>, the phonemes [[...]] and the stress mark before a letter
+var ttsMarkup = regexp.MustCompile(`<\[+|\]+>|\[{2,}|\]{2,}|\+(\pL)`)
+
+type (
+	// MarkupOption configures the conversion of Markdown and HTML documents
+	MarkupOption func(c *markupConfig)
+
+	markupElement string
+	markupKind    int
+
+	markupConfig struct {
+		skip           map[markupElement]bool
+		headingPause   time.Duration
+		paragraphPause time.Duration
+	}
+
+	markupBlock struct {
+		kind markupKind
+		text string
+		// number of the list item, zero for the continuation of the item
+		number int
+	}
+)
+
+const (
+	ElementCodeBlock  markupElement = "code_block"
+	ElementInlineCode markupElement = "inline_code"
+	ElementImage      markupElement = "image"
+	ElementLink       markupElement = "link"
+	ElementHeading    markupElement = "heading"
+	ElementList       markupElement = "list"
+	ElementBlockquote markupElement = "blockquote"
+	ElementTable      markupElement = "table"
+
+	defaultHeadingPause   = 700 * time.Millisecond
+	defaultParagraphPause = 400 * time.Millisecond
+)
+
+const (
+	blockParagraph markupKind = iota
+	blockHeading
+	blockListItem
+)
+
+// SkipElements drops the elements from the spoken text.
+// Code blocks and images are skipped by default.
+func SkipElements(elements ...markupElement) MarkupOption {
+	return func(c *markupConfig) {
+		for _, element := range elements {
+			c.skip[element] = true
+		}
+	}
+}
+
+// KeepElements speaks the elements which are skipped by default,
+// the alternative text is spoken for images
+func KeepElements(elements ...markupElement) MarkupOption {
+	return func(c *markupConfig) {
+		for _, element := range elements {
+			delete(c.skip, element)
+		}
+	}
+}
+
+// HeadingPause sets the pause after headings
+func HeadingPause(pause time.Duration) MarkupOption {
+	return func(c *markupConfig) {
+		c.headingPause = pause
+	}
+}
+
+// ParagraphPause sets the pause after paragraphs and list items
+func ParagraphPause(pause time.Duration) MarkupOption {
+	return func(c *markupConfig) {
+		c.paragraphPause = pause
+	}
+}
+
+// NewMarkdownEntity converts the Markdown document into the text entity with TTS markup pauses
+func NewMarkdownEntity(markdown string, options ...MarkupOption) SimpleTextEntity {
+	config := newMarkupConfig(options...)
+
+	return renderMarkup(parseMarkdown(markdown, config), config)
+}
+
+// NewHTMLEntity converts the HTML document into the text entity with TTS markup pauses
+func NewHTMLEntity(document string, options ...MarkupOption) SimpleTextEntity {
+	config := newMarkupConfig(options...)
+
+	return renderMarkup(parseHTML(document, config), config)
+}
+
+func newMarkupConfig(options ...MarkupOption) markupConfig {
+	config := markupConfig{
+		skip: map[markupElement]bool{
+			ElementCodeBlock: true,
+			ElementImage:     true,
+		},
+		headingPause:   defaultHeadingPause,
+		paragraphPause: defaultParagraphPause,
+	}
+
+	for _, option := range options {
+		option(&config)
+	}
+
+	return config
+}
+
+func (c markupConfig) skipped(element markupElement) bool {
+	return c.skip[element]
+}
+
+// renderMarkup joins the blocks with the sil<[...]> pauses,
+// the entity is empty when there is nothing to speak
+func renderMarkup(blocks []markupBlock, config markupConfig) SimpleTextEntity {
+	var (
+		result strings.Builder
+		pause  time.Duration
+	)
+
+	for _, block := range blocks {
+		text := escapeMarkup(collapseSpaces(block.text))
+
+		if text == "" {
+			continue
+		}
+
+		if result.Len() > 0 {
+			result.WriteString(" ")
+
+			if pause > 0 {
+				_, _ = fmt.Fprintf(&result, "sil<[%d]> ", pause.Milliseconds())
+			}
+		}
+
+		if block.kind == blockListItem && block.number > 0 {
+			text = fmt.Sprintf("%d. %s", block.number, text)
+		}
+
+		result.WriteString(text)
+
+		if pause = config.paragraphPause; block.kind == blockHeading {
+			pause = config.headingPause
+		}
+	}
+
+	return SimpleTextEntity{Text: result.String()}
+}
+
+// escapeMarkup strips the TTS markup from the document text, so that it is spoken as it is written
+func escapeMarkup(text string) string {
+	return ttsMarkup.ReplaceAllStringFunc(text, func(markup string) string {
+		switch {
+		case markup[0] == '<':
+			return "<"
+		case markup[len(markup)-1] == '>':
+			return ">"
+		case markup[0] == '+':
+			return markup[1:]
+		default:
+			return markup[:1]
+		}
+	})
+}
+
+func collapseSpaces(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
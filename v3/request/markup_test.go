@@ -0,0 +1,90 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewMarkdownEntity(t *testing.T) {
+	e := NewMarkdownEntity(
+		"# Title\n\nSome **bold** text with [link](https://ya.ru) and ![image](a.png).\n\n" +
+			"```\ncode()\n```\n\n- one\n- two\n\nEnd",
+	)
+	expected := "Title sil<[700]> Some bold text with link and. sil<[400]> " +
+		"one sil<[400]> two sil<[400]> End"
+
+	if e.Text != expected {
+		t.Errorf("text must be %q, got %q", expected, e.Text)
+		t.FailNow()
+	}
+
+	e = NewMarkdownEntity("# Title\n\ntext", HeadingPause(0), SkipElements(ElementHeading))
+
+	if e.Text != "text" {
+		t.Errorf("heading must be skipped, got %q", e.Text)
+		t.FailNow()
+	}
+
+	if err := NewMarkdownEntity("```\ncode()\n```").Process(NewRequest()); err != ErrEmptyTextEntry {
+		t.Error("empty document must be rejected")
+		t.FailNow()
+	}
+}
+
+func TestNewHTMLEntity(t *testing.T) {
+	e := NewHTMLEntity(
+		`<h1>Title</h1><p>Hello <a href="x">link</a><img alt="pic"></p><pre>code()</pre><ol><li>one</li><li>two</li></ol>`,
+		HeadingPause(time.Second),
+		ParagraphPause(250*time.Millisecond),
+		KeepElements(ElementImage),
+	)
+	expected := "Title sil<[1000]> Hello link pic sil<[250]> 1. one sil<[250]> 2. two"
+
+	if e.Text != expected {
+		t.Errorf("text must be %q, got %q", expected, e.Text)
+		t.FailNow()
+	}
+}
+
+func TestNewMarkdownEntity_Lists(t *testing.T) {
+	tests := map[string]string{
+		"- one\n- two\n\n3. three\n4. four": "one sil<[400]> two sil<[400]> 3. three sil<[400]> 4. four",
+		"- item\n---\nafter the rule":       "item sil<[400]> after the rule",
+	}
+
+	for in, expected := range tests {
+		if e := NewMarkdownEntity(in); e.Text != expected {
+			t.Errorf("text must be %q, got %q", expected, e.Text)
+		}
+	}
+}
+
+func TestNewHTMLEntity_EscapesMarkup(t *testing.T) {
+	e := NewHTMLEntity(`<p>C++ и 2+2, з+амок, [[a]], sil&lt;[500]&gt; и &lt;[[[x]]]&gt;</p>`)
+	expected := "C++ и 2+2, замок, [a], sil<500> и <x>"
+
+	if e.Text != expected {
+		t.Errorf("text must be %q, got %q", expected, e.Text)
+	}
+}
@@ -0,0 +1,116 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import "testing"
+
+func TestMixedLanguageEntity_Runs(t *testing.T) {
+	e := MixedLanguageEntity{
+		Text: "Бұл компания Google Cloud қолданады. Как дела?",
+		Voices: map[lang][]Option{
+			LangRu: {Voice(VoiceAlena)},
+			LangKK: {Voice(VoiceAmira)},
+			LangEn: {Voice(VoiceJohn)},
+		},
+	}
+	expected := []LanguageRun{
+		{Language: LangKK, Text: "Бұл компания"},
+		{Language: LangEn, Text: "Google Cloud"},
+		{Language: LangKK, Text: "қолданады."},
+		{Language: LangRu, Text: "Как дела?"},
+	}
+	runs := e.Runs()
+
+	if len(runs) != len(expected) {
+		t.Errorf("text must be split into %d runs, got %v", len(expected), runs)
+		t.FailNow()
+	}
+
+	for i := range expected {
+		if runs[i] != expected[i] {
+			t.Errorf("run #%d must be %v, got %v", i, expected[i], runs[i])
+		}
+	}
+}
+
+func TestMixedLanguageEntity_RunsApostrophes(t *testing.T) {
+	tests := []struct {
+		text     string
+		language lang
+	}{
+		{text: "Oʻzbekiston poytaxti Toshkent.", language: LangUZ},
+		{text: "Men o'zbek tilida gapiraman.", language: LangUZ},
+		{text: "Bu to’g’ri.", language: LangUZ},
+		{text: "I don't know, it's O’Brien's.", language: LangEn},
+	}
+
+	for _, entry := range tests {
+		runs := MixedLanguageEntity{
+			Text:   entry.text,
+			Voices: map[lang][]Option{LangEn: {Voice(VoiceJohn)}, LangUZ: {Voice(VoiceNigora)}},
+		}.Runs()
+
+		if len(runs) != 1 || runs[0].Language != entry.language || runs[0].Text != entry.text {
+			t.Errorf("%q must be a single %s run, got %v", entry.text, entry.language, runs)
+		}
+	}
+}
+
+func TestMixedLanguageEntity_Dialogue(t *testing.T) {
+	d := MixedLanguageEntity{
+		Text: "Ўзбекистон Google",
+		Voices: map[lang][]Option{
+			LangUZ: {Voice(VoiceNigora)},
+			LangEn: {Voice(VoiceJohn)},
+		},
+	}.Dialogue()
+
+	requests, err := d.Requests(OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate8k))
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if len(requests) != 2 || requests[0].Voice != "nigora" || requests[1].Voice != "john" {
+		t.Error("runs must be spoken by the routed voices")
+		t.FailNow()
+	}
+}
+
+func TestMixedLanguageEntity_DialogueUnrouted(t *testing.T) {
+	d := MixedLanguageEntity{
+		Text:   "Привет. Hello.",
+		Voices: map[lang][]Option{LangUZ: {Voice(VoiceNigora)}},
+	}.Dialogue()
+
+	requests, err := d.Requests(Voice(VoiceJane), OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate8k))
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if len(requests) != 2 || requests[0].Voice != "jane" || requests[1].Voice != "john" {
+		t.Error("unrouted runs must be spoken by the requested or the catalog voice")
+	}
+}
@@ -22,50 +22,61 @@
 package request
 
 type (
-	Option func(req *request) error
+	Option func(req *Request) error
 
-	voice            string
+	lang             string
 	outputFormat     string
 	outputSampleRate int
 	emotion          string
+
+	// VoiceName is the name of the voice, the catalog describes the known voices
+	VoiceName string
+
+	loudnessNormalization string
 )
 
-//goland:noinspection GoExportedFuncWithUnexportedType
-func NewRequest() *request {
-	return &request{}
+// NewRequest creates the empty request the options are applied to
+func NewRequest() *Request {
+	return &Request{}
 }
 
 // voice details
 // https://cloud.yandex.ru/docs/speechkit/tts/voices
 const (
-	VoiceJane      voice = "jane"
-	VoiceOmazh     voice = "omazh"
-	VoiceZahar     voice = "zahar"
-	VoiceErmil     voice = "ermil"
-	VoiceAlena     voice = "alena"
-	VoiceFilipp    voice = "filipp"
-	VoiceAmira     voice = "amira"
-	VoiceMadi      voice = "madi"
-	VoiceMadiRu    voice = "madi_ru"
-	VoiceNigora    voice = "nigora"
-	VoiceLea       voice = "lea"
-	VoiceJohn      voice = "john"
-	VoiceSaule     voice = "saule"
-	VoiceZhanar    voice = "zhanar"
-	VoiceDasha     voice = "dasha"
-	VoiceJulia     voice = "julia"
-	VoiceLera      voice = "lera"
-	VoiceMasha     voice = "masha"
-	VoiceMarina    voice = "marina"
-	VoiceAlexander voice = "alexander"
-	VoiceKirill    voice = "kirill"
-	VoiceAnton     voice = "anton"
-	VoiceSauleRu   voice = "saule_ru"
-	VoiceZamiraRu  voice = "zamira_ru"
-	VoiceZhanarRu  voice = "zhanar_ru"
-	VoiceYulduzRu  voice = "yulduz_ru"
-	VoiceZamira    voice = "zamira"
-	VoiceYulduz    voice = "yulduz"
+	LangRu lang = "ru-RU"
+	LangEn lang = "en-US"
+	LangKK lang = "kk-KK"
+	LangDE lang = "de-DE"
+	LangUZ lang = "uz-UZ"
+
+	VoiceJane      VoiceName = "jane"
+	VoiceOmazh     VoiceName = "omazh"
+	VoiceZahar     VoiceName = "zahar"
+	VoiceErmil     VoiceName = "ermil"
+	VoiceAlena     VoiceName = "alena"
+	VoiceFilipp    VoiceName = "filipp"
+	VoiceAmira     VoiceName = "amira"
+	VoiceMadi      VoiceName = "madi"
+	VoiceMadiRu    VoiceName = "madi_ru"
+	VoiceNigora    VoiceName = "nigora"
+	VoiceLea       VoiceName = "lea"
+	VoiceJohn      VoiceName = "john"
+	VoiceSaule     VoiceName = "saule"
+	VoiceZhanar    VoiceName = "zhanar"
+	VoiceDasha     VoiceName = "dasha"
+	VoiceJulia     VoiceName = "julia"
+	VoiceLera      VoiceName = "lera"
+	VoiceMasha     VoiceName = "masha"
+	VoiceMarina    VoiceName = "marina"
+	VoiceAlexander VoiceName = "alexander"
+	VoiceKirill    VoiceName = "kirill"
+	VoiceAnton     VoiceName = "anton"
+	VoiceSauleRu   VoiceName = "saule_ru"
+	VoiceZamiraRu  VoiceName = "zamira_ru"
+	VoiceZhanarRu  VoiceName = "zhanar_ru"
+	VoiceYulduzRu  VoiceName = "yulduz_ru"
+	VoiceZamira    VoiceName = "zamira"
+	VoiceYulduz    VoiceName = "yulduz"
 
 	EmotionNone     emotion = ""
 	EmotionNeutral  emotion = "neutral"
@@ -79,21 +90,39 @@ const (
 	OutputFormatOggOpus outputFormat = "oggopus"
 	OutputFormatMp3     outputFormat = "mp3"
 	OutputFormatWav     outputFormat = "wav"
+	// OutputFormatULaw and OutputFormatALaw are the G.711 8 kHz telephony formats,
+	// the client requests the lpcm and encodes it locally
+	OutputFormatULaw outputFormat = "ulaw"
+	OutputFormatALaw outputFormat = "alaw"
 
 	OutputSampleRate8k  outputSampleRate = 8000
 	OutputSampleRate16k outputSampleRate = 16000
 	OutputSampleRate48k outputSampleRate = 48000
+
+	// LoudnessNormalizationMaxPeak takes the volume in (0;1], default is 0.7, used by the API when the volume is 0
+	LoudnessNormalizationMaxPeak loudnessNormalization = "max_peak"
+	// LoudnessNormalizationLUFS takes the volume in [-145;0), default is -19, used by the API when not set or the volume is 0
+	LoudnessNormalizationLUFS loudnessNormalization = "lufs"
 )
 
-func Voice(name voice) Option {
-	return func(req *request) error {
+// Language sets the language of the text, it is not sent and only checked against the voice
+func Language(name lang) Option {
+	return func(req *Request) error {
+		req.Language = name
+
+		return nil
+	}
+}
+
+func Voice(name VoiceName) Option {
+	return func(req *Request) error {
 		req.Voice = string(name)
 		return nil
 	}
 }
 
 func Speed(speed float64) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		if speed < 0.1 || speed > 3 {
 			return ErrInvalidSpeakingSpeed
 		}
@@ -105,23 +134,73 @@ func Speed(speed float64) Option {
 }
 
 func Emotion(name emotion) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		req.Emotion = string(name)
 		return nil
 	}
 }
 
 func OutputFormat(name outputFormat) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		req.OutputFormat = name
 		return nil
 	}
 }
 
 func SampleRate(rate outputSampleRate) Option {
-	return func(req *request) error {
+	return func(req *Request) error {
 		req.SampleRate = int(rate)
 
 		return nil
 	}
 }
+
+// LoudnessNormalization sets the normalization type the Volume is measured in
+func LoudnessNormalization(kind loudnessNormalization) Option {
+	return func(req *Request) error {
+		req.LoudnessNormalization = kind
+
+		return nil
+	}
+}
+
+// Volume sets the target volume, the range depends on the LoudnessNormalization and is checked by Validate
+func Volume(volume float64) Option {
+	return func(req *Request) error {
+		req.Volume = volume
+
+		return nil
+	}
+}
+
+// PitchShift raises or lowers the voice pitch by hz in [-1000;1000]
+func PitchShift(hz float64) Option {
+	return func(req *Request) error {
+		if hz < -1000 || hz > 1000 {
+			return ErrInvalidPitchShift
+		}
+
+		req.PitchShift = hz
+
+		return nil
+	}
+}
+
+// Model sets the synthesis model for the brand voices and the custom models,
+// audio templates use the zsl model when it is not set
+func Model(name string) Option {
+	return func(req *Request) error {
+		req.Model = name
+
+		return nil
+	}
+}
+
+// UnsafeMode lets the API split the long text into several billed utterances
+func UnsafeMode(enabled bool) Option {
+	return func(req *Request) error {
+		req.UnsafeMode = enabled
+
+		return nil
+	}
+}
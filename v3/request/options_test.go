@@ -120,3 +120,17 @@ func TestSampleRate(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func TestPitchShift(t *testing.T) {
+	r := NewRequest()
+
+	if err := PitchShift(-1000)(r); err != nil || r.PitchShift != -1000 {
+		t.Error("pitch shift must be -1000")
+		t.FailNow()
+	}
+
+	if err := PitchShift(1000.5)(r); err != ErrInvalidPitchShift || r.PitchShift != -1000 {
+		t.Error("pitch shift out of range must be rejected")
+		t.FailNow()
+	}
+}
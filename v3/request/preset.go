@@ -0,0 +1,417 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+)
+
+var (
+	ErrUnknownPreset   = errors.New("unknown preset")
+	ErrDuplicatePreset = errors.New("duplicate preset")
+	ErrEmptyPresetName = errors.New("empty preset name")
+	ErrPresetCycle     = errors.New("preset inheritance cycle")
+	ErrUnknownLanguage = errors.New("unknown language")
+)
+
+type (
+	// Preset is the named set of options, the empty fields are inherited from the Extends preset.
+	// The zero value means the field is not set, so a child can not reset the inherited field to zero,
+	// except UnsafeMode.
+	Preset struct {
+		Name         string  `json:"name,omitempty" yaml:"name,omitempty"`
+		Extends      string  `json:"extends,omitempty" yaml:"extends,omitempty"`
+		Voice        string  `json:"voice,omitempty" yaml:"voice,omitempty"`
+		Language     string  `json:"lang,omitempty" yaml:"lang,omitempty"`
+		Emotion      string  `json:"emotion,omitempty" yaml:"emotion,omitempty"`
+		Speed        float64 `json:"speed,omitempty" yaml:"speed,omitempty"`
+		OutputFormat string  `json:"format,omitempty" yaml:"format,omitempty"`
+		SampleRate   int     `json:"sample_rate,omitempty" yaml:"sample_rate,omitempty"`
+		PitchShift   float64 `json:"pitch_shift,omitempty" yaml:"pitch_shift,omitempty"`
+		Model        string  `json:"model,omitempty" yaml:"model,omitempty"`
+		// UnsafeMode is a pointer so the child preset can turn it off
+		UnsafeMode *bool `json:"unsafe_mode,omitempty" yaml:"unsafe_mode,omitempty"`
+		// Volume is measured in the LoudnessNormalization units
+		LoudnessNormalization string  `json:"loudness_normalization,omitempty" yaml:"loudness_normalization,omitempty"`
+		Volume                float64 `json:"volume,omitempty" yaml:"volume,omitempty"`
+	}
+
+	// Presets keeps the presets by name with the inheritance resolved
+	Presets struct {
+		presets map[string]Preset
+	}
+
+	// PresetError is the problem of the preset field
+	PresetError struct {
+		Preset string
+		Field  string
+		Err    error
+	}
+
+	// presetOption is the option of the set preset field
+	presetOption struct {
+		field  string
+		option Option
+	}
+)
+
+func (e *PresetError) Error() string {
+	return fmt.Sprintf("preset %q: %s: %s", e.Preset, e.Field, e.Err)
+}
+
+func (e *PresetError) Unwrap() error {
+	return e.Err
+}
+
+// NewPresets resolves the inheritance and validates the presets, all problems including the empty
+// and duplicate names are returned as ValidationError
+func NewPresets(presets ...Preset) (*Presets, error) {
+	var (
+		defined  = make(map[string]Preset, len(presets))
+		result   = &Presets{presets: make(map[string]Preset, len(presets))}
+		problems ValidationError
+	)
+
+	for _, preset := range presets {
+		if preset.Name == "" {
+			problems = append(problems, &PresetError{Field: "name", Err: ErrEmptyPresetName})
+		} else if _, ok := defined[preset.Name]; ok {
+			problems = append(problems, &PresetError{Preset: preset.Name, Field: "name", Err: ErrDuplicatePreset})
+		} else {
+			defined[preset.Name] = preset
+		}
+	}
+
+	for _, name := range presetNames(defined) {
+		preset, err := resolvePreset(defined, name, map[string]bool{})
+
+		if err != nil {
+			problems = append(problems, err)
+
+			continue
+		}
+
+		problems = append(problems, preset.validate()...)
+		result.presets[name] = preset
+	}
+
+	if err := problems.orNil(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReadPresets reads the YAML or JSON object of the presets keyed by name
+func ReadPresets(r io.Reader) (*Presets, error) {
+	data, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var defined map[string]Preset
+
+	if err = yaml.Unmarshal(data, &defined); err != nil {
+		return nil, err
+	}
+
+	presets := make([]Preset, 0, len(defined))
+
+	for _, name := range presetNames(defined) {
+		preset := defined[name]
+		preset.Name = name
+		presets = append(presets, preset)
+	}
+
+	return NewPresets(presets...)
+}
+
+// LoadPresets reads the presets from the YAML or JSON file
+func LoadPresets(path string) (*Presets, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	return ReadPresets(f)
+}
+
+// Names returns the sorted names of the presets
+func (p *Presets) Names() []string {
+	return presetNames(p.presets)
+}
+
+// Preset returns the preset with the inherited fields
+func (p *Presets) Preset(name string) (Preset, error) {
+	preset, ok := p.presets[name]
+
+	if !ok {
+		return Preset{}, fmt.Errorf("%w %q", ErrUnknownPreset, name)
+	}
+
+	return preset, nil
+}
+
+// Options returns the options of the preset with the inherited fields
+func (p *Presets) Options(name string) ([]Option, error) {
+	preset, err := p.Preset(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return preset.Options(), nil
+}
+
+// Options returns the options of the set fields, the inheritance is not resolved
+func (p Preset) Options() []Option {
+	var options []Option
+
+	for _, option := range p.options() {
+		options = append(options, option.option)
+	}
+
+	return options
+}
+
+func (p Preset) options() []presetOption {
+	var options []presetOption
+
+	if p.Language != "" {
+		options = append(options, presetOption{"lang", Language(lang(p.Language))})
+	}
+
+	if p.Voice != "" {
+		options = append(options, presetOption{"voice", Voice(VoiceName(p.Voice))})
+	}
+
+	if p.Emotion != "" {
+		options = append(options, presetOption{"emotion", Emotion(emotion(p.Emotion))})
+	}
+
+	if p.Speed != 0 {
+		options = append(options, presetOption{"speed", Speed(p.Speed)})
+	}
+
+	if p.OutputFormat != "" {
+		options = append(options, presetOption{"format", OutputFormat(outputFormat(p.OutputFormat))})
+	}
+
+	if p.SampleRate != 0 {
+		options = append(options, presetOption{"sample_rate", SampleRate(outputSampleRate(p.SampleRate))})
+	}
+
+	if p.PitchShift != 0 {
+		options = append(options, presetOption{"pitch_shift", PitchShift(p.PitchShift)})
+	}
+
+	if p.Model != "" {
+		options = append(options, presetOption{"model", Model(p.Model)})
+	}
+
+	if p.UnsafeMode != nil {
+		options = append(options, presetOption{"unsafe_mode", UnsafeMode(*p.UnsafeMode)})
+	}
+
+	if p.LoudnessNormalization != "" {
+		options = append(options, presetOption{
+			"loudness_normalization", LoudnessNormalization(loudnessNormalization(p.LoudnessNormalization)),
+		})
+	}
+
+	if p.Volume != 0 {
+		options = append(options, presetOption{"volume", Volume(p.Volume)})
+	}
+
+	return options
+}
+
+// inherit fills the empty fields from the parent
+func (p Preset) inherit(parent Preset) Preset {
+	if p.Voice == "" {
+		p.Voice = parent.Voice
+	}
+
+	if p.Language == "" {
+		p.Language = parent.Language
+	}
+
+	if p.Emotion == "" {
+		p.Emotion = parent.Emotion
+	}
+
+	if p.Speed == 0 {
+		p.Speed = parent.Speed
+	}
+
+	if p.OutputFormat == "" {
+		p.OutputFormat = parent.OutputFormat
+	}
+
+	if p.SampleRate == 0 {
+		p.SampleRate = parent.SampleRate
+	}
+
+	if p.PitchShift == 0 {
+		p.PitchShift = parent.PitchShift
+	}
+
+	if p.Model == "" {
+		p.Model = parent.Model
+	}
+
+	if p.UnsafeMode == nil {
+		p.UnsafeMode = parent.UnsafeMode
+	}
+
+	if p.LoudnessNormalization == "" {
+		p.LoudnessNormalization = parent.LoudnessNormalization
+	}
+
+	if p.Volume == 0 {
+		p.Volume = parent.Volume
+	}
+
+	return p
+}
+
+// validate applies the options to the request and validates it,
+// the problems are reported for the preset fields they are caused by
+func (p Preset) validate() ValidationError {
+	var (
+		problems ValidationError
+		rejected ValidationError
+		r        = NewRequest()
+	)
+
+	fail := func(field string, err error) {
+		problems = append(problems, &PresetError{Preset: p.Name, Field: field, Err: err})
+	}
+
+	if p.Language != "" && !knownLanguage(lang(p.Language)) {
+		fail("lang", fmt.Errorf("%w %q", ErrUnknownLanguage, p.Language))
+	}
+
+	for _, option := range p.options() {
+		if err := option.option(r); err != nil {
+			rejected = append(rejected, &PresetError{Preset: p.Name, Field: option.field, Err: err})
+		}
+	}
+
+	if !knownLanguage(r.Language) {
+		r.Language = ""
+	}
+
+	// the format and the lpcm sample rate may be given by the caller
+	if r.OutputFormat == "" && r.SampleRate != 0 {
+		r.OutputFormat = OutputFormatLPCM
+	} else if r.OutputFormat == "" {
+		r.OutputFormat = OutputFormatOggOpus
+	} else if r.OutputFormat == OutputFormatLPCM && r.SampleRate == 0 {
+		r.SampleRate = int(OutputSampleRate48k)
+	}
+
+	var invalid ValidationError
+
+	if errors.As(r.Validate(), &invalid) {
+		for _, err := range invalid {
+			fail(presetField(err), err)
+		}
+	}
+
+	return append(problems, rejected...)
+}
+
+// presetField returns the preset field which causes the request validation problem
+func presetField(err error) string {
+	switch {
+	case errors.Is(err, ErrUnknownVoice):
+		return "voice"
+	case errors.Is(err, ErrVoiceLanguage):
+		return "lang"
+	case errors.Is(err, ErrUnsupportedEmotion):
+		return "emotion"
+	case errors.Is(err, ErrInvalidOutputFormat):
+		return "format"
+	case errors.Is(err, ErrInvalidSampleRate):
+		return "sample_rate"
+	case errors.Is(err, ErrInvalidLoudness):
+		return "loudness_normalization"
+	case errors.Is(err, ErrInvalidVolume):
+		return "volume"
+	}
+
+	return ""
+}
+
+// resolvePreset returns the preset with the fields inherited through the Extends chain
+func resolvePreset(defined map[string]Preset, name string, visiting map[string]bool) (Preset, error) {
+	preset := defined[name]
+
+	if preset.Extends == "" {
+		return preset, nil
+	} else if visiting[name] {
+		return preset, &PresetError{Preset: name, Field: "extends", Err: ErrPresetCycle}
+	} else if _, ok := defined[preset.Extends]; !ok {
+		return preset, &PresetError{Preset: name, Field: "extends", Err: fmt.Errorf("%w %q", ErrUnknownPreset, preset.Extends)}
+	}
+
+	visiting[name] = true
+	parent, err := resolvePreset(defined, preset.Extends, visiting)
+
+	if err != nil {
+		return preset, err
+	}
+
+	return preset.inherit(parent), nil
+}
+
+func knownLanguage(language lang) bool {
+	switch language {
+	case LangRu, LangEn, LangKK, LangDE, LangUZ:
+		return true
+	}
+
+	return false
+}
+
+func presetNames(presets map[string]Preset) []string {
+	names := make([]string, 0, len(presets))
+
+	for name := range presets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
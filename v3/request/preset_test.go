@@ -0,0 +1,154 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testPresets = `
+ivr-ru:
+  voice: alena
+  lang: ru-RU
+  emotion: good
+  format: lpcm
+  sample_rate: 8000
+  loudness_normalization: max_peak
+  volume: 0.9
+  unsafe_mode: true
+ivr-ru-brand:
+  extends: ivr-ru
+  model: brand
+  pitch_shift: -100
+  unsafe_mode: false
+`
+
+func TestReadPresets(t *testing.T) {
+	presets, err := ReadPresets(strings.NewReader(testPresets))
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	options, err := presets.Options("ivr-ru-brand")
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	r := NewRequest()
+
+	for _, option := range options {
+		if err = option(r); err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+	}
+
+	want := Request{
+		Voice:                 "alena",
+		Language:              LangRu,
+		Emotion:               "good",
+		OutputFormat:          OutputFormatLPCM,
+		SampleRate:            8000,
+		LoudnessNormalization: LoudnessNormalizationMaxPeak,
+		Volume:                0.9,
+		PitchShift:            -100,
+		Model:                 "brand",
+	}
+
+	if !reflect.DeepEqual(*r, want) {
+		t.Errorf("got %s, want %s", r.Describe(), want.Describe())
+	}
+
+	if _, err = presets.Preset("missing"); !errors.Is(err, ErrUnknownPreset) {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestNewPresets_Invalid(t *testing.T) {
+	_, err := NewPresets(
+		Preset{Name: "base", Voice: "marina", Emotion: "whisper", LoudnessNormalization: "max_peak"},
+		Preset{Name: "loud", Extends: "base", Volume: -19, PitchShift: 2000},
+		Preset{Name: "john", Extends: "base", Voice: "john", LoudnessNormalization: "rms"},
+	)
+
+	var problems ValidationError
+
+	if !errors.As(err, &problems) {
+		t.Errorf("got %v", err)
+		t.FailNow()
+	}
+
+	var fields []string
+
+	for _, problem := range problems {
+		var presetErr *PresetError
+
+		if errors.As(problem, &presetErr) {
+			fields = append(fields, presetErr.Preset+"."+presetErr.Field)
+		}
+	}
+
+	// the options are checked after the request validation
+	want := []string{"john.emotion", "john.loudness_normalization", "loud.volume", "loud.pitch_shift"}
+
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("got %v, want %v", fields, want)
+	}
+}
+
+func TestNewPresets_Duplicate(t *testing.T) {
+	_, err := NewPresets(Preset{Name: "ivr", Voice: "alena"}, Preset{Name: "ivr", Voice: "jane"})
+
+	if !errors.Is(err, ErrDuplicatePreset) {
+		t.Errorf("duplicate preset must be rejected, got %v", err)
+	}
+
+	_, err = NewPresets(
+		Preset{Voice: "alena"},
+		Preset{Name: "ivr", Voice: "alena"},
+		Preset{Name: "ivr", Voice: "jane"},
+		Preset{Name: "fast", Speed: 5},
+	)
+
+	var problems ValidationError
+
+	if !errors.As(err, &problems) || len(problems) != 3 {
+		t.Errorf("all problems must be collected, got %v", err)
+	}
+
+	for _, want := range []error{ErrEmptyPresetName, ErrDuplicatePreset, ErrInvalidSpeakingSpeed} {
+		if !errors.Is(err, want) {
+			t.Errorf("%v must contain %v", err, want)
+		}
+	}
+
+	if errors.Is(err, ErrUnknownPreset) {
+		t.Errorf("empty name must not be reported as the unknown preset, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTextProcessors(t *testing.T) {
+	r := NewRequest()
+	option := TextProcessors(WhitespaceProcessor{}, EmojiProcessor{})
+
+	if err := option(r); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if err := TextProcessors(URLProcessor{})(r); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if len(r.Processors) != 3 {
+		t.Error("processors chain must contain 3 processors")
+		t.FailNow()
+	}
+}
+
+func TestSimpleTextEntity_ProcessWithProcessors(t *testing.T) {
+	r := NewRequest()
+	r.Processors = []TextProcessor{
+		URLProcessor{},
+		EmojiProcessor{},
+		WhitespaceProcessor{},
+		TextProcessorFunc(func(text string) (string, error) {
+			return strings.ToUpper(text), nil
+		}),
+	}
+
+	if err := (SimpleTextEntity{Text: " go to 👉 https://ya.ru  now "}).Process(r); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if r.Text != "GO TO NOW" {
+		t.Errorf("text must be processed, got %q", r.Text)
+		t.FailNow()
+	}
+
+	if err := (SimpleTextEntity{Text: "www.ya.ru"}).Process(r); err != ErrEmptyTextEntry {
+		t.Error("text emptied by processors must be rejected")
+		t.FailNow()
+	}
+}
+
+func TestEmojiProcessor_ProcessText(t *testing.T) {
+	tests := map[string]string{
+		"hello 👋🏽 world ❤️ 👨‍👩‍👧":     "hello  world  ",
+		"⌀ 5 мм ☎ ✓ готово ➔ далее ★": "⌀ 5 мм ☎ ✓ готово ➔ далее ★",
+		"звоните ☎️ ✅ готово 1️⃣":     "звоните   готово 1",
+	}
+
+	for in, expected := range tests {
+		if out, _ := (EmojiProcessor{}).ProcessText(in); out != expected {
+			t.Errorf("%q: got %q, want %q", in, out, expected)
+		}
+	}
+}
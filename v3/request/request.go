@@ -32,87 +32,133 @@ var (
 	ErrInvalidSpeakingSpeed     = errors.New("invalid speaking speed")
 	ErrOutputFormatNotSpecified = errors.New("output format not specified")
 	ErrInvalidOutputFormat      = errors.New("invalid output format")
+	ErrInvalidLoudness          = errors.New("invalid loudness normalization")
+	ErrInvalidVolume            = errors.New("invalid volume")
+	ErrInvalidPitchShift        = errors.New("invalid pitch shift")
 )
 
-type request struct {
+// lpcmSampleRate22k is accepted by the raw audio besides the OutputSampleRate constants
+const lpcmSampleRate22k = 22050
+
+type Request struct {
 	AudioTemplate *audioTemplate
+	TextTemplate  *textTemplate
 	Text          string
 	Voice         string
+	Language      lang
 	Speed         float64
 	Emotion       string
 	SampleRate    int
 	OutputFormat  outputFormat
+	Processors    []TextProcessor
+	Lexicons      []*Lexicon
+	// LoudnessNormalization and Volume are left to the API defaults when empty
+	LoudnessNormalization loudnessNormalization
+	Volume                float64
+	PitchShift            float64
+	Model                 string
+	UnsafeMode            bool
 }
 
-func (r request) Build() (*tts.UtteranceSynthesisRequest, error) {
+func (r Request) Build() (*tts.UtteranceSynthesisRequest, error) {
 	result, err := r.buildRequest()
 
 	if err != nil {
 		return nil, err
 	}
 
+	if result.LoudnessNormalizationType, err = buildLoudnessNormalization(r.LoudnessNormalization); err != nil {
+		return nil, err
+	}
+
+	if r.PitchShift != 0 {
+		result.Hints = append(result.Hints, &tts.Hints{
+			Hint: &tts.Hints_PitchShift{PitchShift: r.PitchShift},
+		})
+	}
+
+	if r.Volume != 0 {
+		result.Hints = append(result.Hints, &tts.Hints{
+			Hint: &tts.Hints_Volume{Volume: r.Volume},
+		})
+	}
+
 	result.OutputAudioSpec, err = buildAudioFormat(r.OutputFormat, r.SampleRate)
 
 	return result, err
 }
 
-func (r request) buildRequest() (*tts.UtteranceSynthesisRequest, error) {
+func (r Request) buildRequest() (*tts.UtteranceSynthesisRequest, error) {
 	var (
 		result = tts.UtteranceSynthesisRequest{
 			Utterance:       nil,
 			Hints:           nil,
 			OutputAudioSpec: nil,
-			UnsafeMode:      false,
+			Model:           r.Model,
+			UnsafeMode:      r.UnsafeMode,
 		}
-		hints = make([]*tts.Hints, 0)
-		err   error
+		err error
 	)
 
 	if r.Text != "" {
 		result.Utterance = &tts.UtteranceSynthesisRequest_Text{
 			Text: r.Text,
 		}
+		result.Hints, err = r.voiceHints()
+	} else if r.TextTemplate != nil {
+		result.Utterance = r.TextTemplate.TextTemplate()
+		result.Hints, err = r.voiceHints()
+	} else if r.AudioTemplate != nil {
+		result.Utterance = r.AudioTemplate.TextTemplate()
+		result.Hints, err = r.AudioTemplate.Hints()
 
-		if r.Voice == "" {
-			return nil, ErrVoiceNotSpecified
+		if result.Model == "" {
+			result.Model = "zsl"
 		}
+	} else {
+		return nil, ErrNoSpeakEntity
+	}
 
-		hints = append(
-			hints, &tts.Hints{
-				Hint: &tts.Hints_Voice{Voice: r.Voice},
-			},
-		)
+	if err != nil {
+		return nil, err
+	}
 
-		if r.Speed != 0 {
-			if r.Speed < 0.1 || r.Speed > 3 {
-				return nil, ErrInvalidSpeakingSpeed
-			}
+	return &result, nil
+}
 
-			hints = append(
-				hints, &tts.Hints{
-					Hint: &tts.Hints_Speed{Speed: r.Speed},
-				},
-			)
+// voiceHints builds the voice, speed and role hints of the text utterances
+func (r Request) voiceHints() ([]*tts.Hints, error) {
+	hints := make([]*tts.Hints, 0)
+
+	if r.Voice == "" {
+		return nil, ErrVoiceNotSpecified
+	}
+
+	hints = append(
+		hints, &tts.Hints{
+			Hint: &tts.Hints_Voice{Voice: r.Voice},
+		},
+	)
+
+	if r.Speed != 0 {
+		if r.Speed < 0.1 || r.Speed > 3 {
+			return nil, ErrInvalidSpeakingSpeed
 		}
 
 		hints = append(
 			hints, &tts.Hints{
-				Hint: &tts.Hints_Role{Role: r.Emotion},
+				Hint: &tts.Hints_Speed{Speed: r.Speed},
 			},
 		)
-
-		if len(hints) > 0 {
-			result.Hints = hints
-		}
-	} else if r.AudioTemplate != nil {
-		result.Utterance = r.AudioTemplate.TextTemplate()
-		result.Model = "zsl"
-		result.Hints, err = r.AudioTemplate.Hints()
-	} else {
-		return nil, ErrNoSpeakEntity
 	}
 
-	return &result, err
+	hints = append(
+		hints, &tts.Hints{
+			Hint: &tts.Hints_Role{Role: r.Emotion},
+		},
+	)
+
+	return hints, nil
 }
 
 func buildAudioFormat(format outputFormat, sampleRate int) (*tts.AudioFormatOptions, error) {
@@ -156,3 +202,16 @@ func buildAudioFormat(format outputFormat, sampleRate int) (*tts.AudioFormatOpti
 		return nil, ErrInvalidOutputFormat
 	}
 }
+
+func buildLoudnessNormalization(kind loudnessNormalization) (tts.UtteranceSynthesisRequest_LoudnessNormalizationType, error) {
+	switch kind {
+	case "":
+		return tts.UtteranceSynthesisRequest_LOUDNESS_NORMALIZATION_TYPE_UNSPECIFIED, nil
+	case LoudnessNormalizationMaxPeak:
+		return tts.UtteranceSynthesisRequest_MAX_PEAK, nil
+	case LoudnessNormalizationLUFS:
+		return tts.UtteranceSynthesisRequest_LUFS, nil
+	default:
+		return 0, ErrInvalidLoudness
+	}
+}
@@ -1,6 +1,9 @@
 package request
 
-import "testing"
+import (
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
+	"testing"
+)
 
 func TestRequest_Build(t *testing.T) {
 	r := NewRequest()
@@ -23,3 +26,153 @@ func TestRequest_Build(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func TestRequest_BuildLoudness(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		kind    tts.UtteranceSynthesisRequest_LoudnessNormalizationType
+		volume  float64
+	}{
+		{
+			name: "api defaults",
+			kind: tts.UtteranceSynthesisRequest_LOUDNESS_NORMALIZATION_TYPE_UNSPECIFIED,
+		},
+		{
+			name:    "max peak",
+			options: []Option{LoudnessNormalization(LoudnessNormalizationMaxPeak), Volume(0.5)},
+			kind:    tts.UtteranceSynthesisRequest_MAX_PEAK,
+			volume:  0.5,
+		},
+		{
+			name:    "lufs",
+			options: []Option{LoudnessNormalization(LoudnessNormalizationLUFS), Volume(-23)},
+			kind:    tts.UtteranceSynthesisRequest_LUFS,
+			volume:  -23,
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			r := NewRequest()
+			r.Voice = "alena"
+			r.Text = "hello"
+			r.OutputFormat = OutputFormatMp3
+
+			for _, option := range entry.options {
+				_ = option(r)
+			}
+
+			req, err := r.Build()
+
+			if err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			if req.LoudnessNormalizationType != entry.kind {
+				t.Errorf("got %s, want %s", req.LoudnessNormalizationType, entry.kind)
+			}
+
+			var volume float64
+
+			for _, hint := range req.Hints {
+				if v, ok := hint.Hint.(*tts.Hints_Volume); ok {
+					volume = v.Volume
+				}
+			}
+
+			if volume != entry.volume {
+				t.Errorf("got volume %g, want %g", volume, entry.volume)
+			}
+		})
+	}
+}
+
+func TestRequest_BuildModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		entity  TextEntity
+		model   string
+		pitch   float64
+		unsafe  bool
+	}{
+		{
+			name:   "text defaults",
+			entity: SimpleTextEntity{Text: "hello"},
+		},
+		{
+			name:    "text with brand model",
+			options: []Option{Model("brand-voice"), PitchShift(-200), UnsafeMode(true)},
+			entity:  SimpleTextEntity{Text: "hello"},
+			model:   "brand-voice",
+			pitch:   -200,
+			unsafe:  true,
+		},
+		{
+			name:    "text template",
+			options: []Option{PitchShift(150)},
+			entity:  TextTemplateEntity{Template: "hello {name}", Variables: map[string]string{"name": "Алексей"}},
+			pitch:   150,
+		},
+		{
+			name: "audio template uses zsl by default",
+			entity: NewAudioTemplateEntity(
+				"hello {name}", map[string]string{"{name}": "Алексей"}, nil, make([]byte, 1600), OutputFormatLPCM, 8000,
+			),
+			model: "zsl",
+		},
+		{
+			name:    "audio template with custom model",
+			options: []Option{Model("custom"), UnsafeMode(true), PitchShift(10)},
+			entity: NewAudioTemplateEntity(
+				"hello {name}", map[string]string{"{name}": "Алексей"}, nil, make([]byte, 1600), OutputFormatLPCM, 8000,
+			),
+			model:  "custom",
+			pitch:  10,
+			unsafe: true,
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			r := NewRequest()
+			r.Voice = "alena"
+			r.OutputFormat = OutputFormatOggOpus
+
+			for _, option := range entry.options {
+				if err := option(r); err != nil {
+					t.Error(err)
+					t.FailNow()
+				}
+			}
+
+			if err := entry.entity.Process(r); err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			req, err := r.Build()
+
+			if err != nil {
+				t.Error(err)
+				t.FailNow()
+			}
+
+			if req.Model != entry.model || req.UnsafeMode != entry.unsafe {
+				t.Errorf("got model %q and unsafe mode %t", req.Model, req.UnsafeMode)
+			}
+
+			var pitch float64
+
+			for _, hint := range req.Hints {
+				pitch += hint.GetPitchShift()
+			}
+
+			if pitch != entry.pitch {
+				t.Errorf("got pitch shift %g, want %g", pitch, entry.pitch)
+			}
+		})
+	}
+}
@@ -31,7 +31,7 @@ var (
 
 type (
 	TextEntity interface {
-		Process(req *request) error
+		Process(req *Request) error
 	}
 
 	SimpleTextEntity struct {
@@ -63,22 +63,32 @@ func NewAudioTemplateEntity(
 	}
 }
 
-func (e AudioTemplateEntity) Process(req *request) error {
+func (e AudioTemplateEntity) Process(req *Request) error {
 	if len(e.audioSource) == 0 {
 		return ErrNoSpeakEntity
 	}
 
+	req.Text = ""
+	req.TextTemplate = nil
 	req.AudioTemplate = &e.audioTemplate
 
 	return nil
 }
 
-func (e SimpleTextEntity) Process(req *request) error {
+func (e SimpleTextEntity) Process(req *Request) error {
 	if e.Text == "" {
 		return ErrEmptyTextEntry
 	}
 
-	req.Text = e.Text
+	text, err := req.processText(e.Text)
+
+	if err != nil {
+		return err
+	} else if text == "" {
+		return ErrEmptyTextEntry
+	}
+
+	req.Text = renderLexicon(text, matchLexicons(req.Lexicons, text))
 
 	return nil
 }
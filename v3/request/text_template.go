@@ -0,0 +1,181 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"fmt"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrTemplateVariables = errors.New("template variables mismatch")
+
+	templatePlaceholder = regexp.MustCompile(`\{([^{}\s]+)\}`)
+)
+
+type (
+	// TextTemplateEntity is the text template with the variables synthesized without a reference audio.
+	// Variable names may be given with or without the surrounding braces, but not both ways at once.
+	TextTemplateEntity struct {
+		Template  string
+		Variables map[string]string
+	}
+
+	textTemplate struct {
+		template  string
+		variables map[string]string
+	}
+)
+
+func (e TextTemplateEntity) Process(req *Request) error {
+	if e.Template == "" {
+		return ErrEmptyTextEntry
+	}
+
+	variables, err := checkTemplateVariables(e.Template, e.Variables)
+
+	if err != nil {
+		return err
+	}
+
+	req.Text = ""
+	req.AudioTemplate = nil
+	req.TextTemplate = &textTemplate{
+		template:  e.Template,
+		variables: variables,
+	}
+
+	return nil
+}
+
+// TextTemplate builds the utterance with the variables sorted by name
+func (t textTemplate) TextTemplate() *tts.UtteranceSynthesisRequest_TextTemplate {
+	utterance := &tts.UtteranceSynthesisRequest_TextTemplate{
+		TextTemplate: &tts.TextTemplate{
+			TextTemplate: t.template,
+			Variables:    make([]*tts.TextVariable, 0, len(t.variables)),
+		},
+	}
+
+	for _, name := range sortedKeys(t.variables) {
+		utterance.TextTemplate.Variables = append(
+			utterance.TextTemplate.Variables,
+			&tts.TextVariable{
+				VariableName:  name,
+				VariableValue: t.variables[name],
+			},
+		)
+	}
+
+	return utterance
+}
+
+// templatePlaceholders returns the names of the {placeholder} tokens of the template
+func templatePlaceholders(template string) map[string]bool {
+	result := map[string]bool{}
+
+	for _, m := range templatePlaceholder.FindAllStringSubmatch(template, -1) {
+		result[m[1]] = true
+	}
+
+	return result
+}
+
+// checkTemplateVariables checks that every placeholder of the template has a variable
+// and every variable has a placeholder, the variables are returned with the braced names
+func checkTemplateVariables(template string, variables map[string]string) (map[string]string, error) {
+	if err := checkTemplateNames(template, sortedKeys(variables)); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(variables))
+
+	for name, value := range variables {
+		result[bracedName(name)] = value
+	}
+
+	return result, nil
+}
+
+// checkTemplateNames checks that the names match the placeholders of the template,
+// a variable must not be named both with and without the braces
+func checkTemplateNames(template string, names []string) error {
+	var (
+		placeholders = templatePlaceholders(template)
+		known        = make(map[string]string, len(names))
+		missing      []string
+		unknown      []string
+	)
+
+	for _, name := range names {
+		braced := bracedName(name)
+
+		if previous, ok := known[braced]; ok {
+			return fmt.Errorf("%w: %q and %q name the same variable", ErrTemplateVariables, previous, name)
+		}
+
+		known[braced] = name
+
+		if !placeholders[strings.Trim(braced, "{}")] {
+			unknown = append(unknown, name)
+		}
+	}
+
+	for name := range placeholders {
+		if _, ok := known["{"+name+"}"]; !ok {
+			missing = append(missing, "{"+name+"}")
+		}
+	}
+
+	if len(missing) == 0 && len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unknown)
+
+	return fmt.Errorf(
+		"%w: missing [%s], unknown [%s]",
+		ErrTemplateVariables, strings.Join(missing, ", "), strings.Join(unknown, ", "),
+	)
+}
+
+// bracedName returns the variable name surrounded by the braces
+func bracedName(name string) string {
+	return "{" + strings.TrimSuffix(strings.TrimPrefix(name, "{"), "}") + "}"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,133 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTextTemplateEntity_Process(t *testing.T) {
+	r := NewRequest()
+	r.Voice = "alena"
+	r.OutputFormat = OutputFormatOggOpus
+
+	err := TextTemplateEntity{
+		Template:  "Здравствуйте, {name}! Ваш заказ {order} готов.",
+		Variables: map[string]string{"name": "Алексей", "{order}": "42"},
+	}.Process(r)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	req, err := r.Build()
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	template := req.GetTextTemplate()
+
+	if template == nil || template.TextTemplate != "Здравствуйте, {name}! Ваш заказ {order} готов." {
+		t.Error("utterance must be the text template")
+		t.FailNow()
+	}
+
+	if len(template.Variables) != 2 ||
+		template.Variables[0].VariableName != "{name}" || template.Variables[0].VariableValue != "Алексей" ||
+		template.Variables[1].VariableName != "{order}" || template.Variables[1].VariableValue != "42" {
+		t.Errorf("variables must be sorted and braced, got %v", template.Variables)
+		t.FailNow()
+	}
+
+	if req.Model != "" || len(req.Hints) != 2 || req.Hints[0].GetVoice() != "alena" {
+		t.Error("text template must be synthesized by the voice without the audio template")
+		t.FailNow()
+	}
+}
+
+func TestTextTemplateEntity_ProcessMismatch(t *testing.T) {
+	err := TextTemplateEntity{
+		Template:  "Здравствуйте, {name}! Ваш заказ {order} готов.",
+		Variables: map[string]string{"name": "Алексей", "date": "завтра"},
+	}.Process(NewRequest())
+
+	if !errors.Is(err, ErrTemplateVariables) {
+		t.Error("variables mismatch must be rejected")
+		t.FailNow()
+	}
+
+	if err.Error() != "template variables mismatch: missing [{order}], unknown [date]" {
+		t.Errorf("error must name the variables, got %q", err.Error())
+		t.FailNow()
+	}
+
+	err = TextTemplateEntity{
+		Template:  "Здравствуйте, {name}!",
+		Variables: map[string]string{"name": "Алексей", "{name}": "Иван"},
+	}.Process(NewRequest())
+
+	if !errors.Is(err, ErrTemplateVariables) {
+		t.Error("variable named with and without the braces must be rejected")
+		t.FailNow()
+	}
+
+	if err := (TextTemplateEntity{}).Process(NewRequest()); err != ErrEmptyTextEntry {
+		t.Error("empty template must be rejected")
+		t.FailNow()
+	}
+}
+
+func TestTemplateEntities_Replace(t *testing.T) {
+	r := NewRequest()
+
+	if err := (TextTemplateEntity{Template: "{name}", Variables: map[string]string{"name": "Алексей"}}).Process(r); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	err := NewAudioTemplateEntity("{name}", map[string]string{"name": "Иван"}, nil, make([]byte, 16000), OutputFormatLPCM, 8000).
+		Process(r)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if r.TextTemplate != nil || r.AudioTemplate == nil {
+		t.Error("audio template must replace the text template")
+		t.FailNow()
+	}
+
+	if err := (TextTemplateEntity{Template: "{name}", Variables: map[string]string{"name": "Алексей"}}).Process(r); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if r.TextTemplate == nil || r.AudioTemplate != nil {
+		t.Error("text template must replace the audio template")
+		t.FailNow()
+	}
+}
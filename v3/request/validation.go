@@ -0,0 +1,131 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnknownVoice       = errors.New("unknown voice")
+	ErrVoiceLanguage      = errors.New("voice does not speak the language")
+	ErrUnsupportedEmotion = errors.New("emotion is not supported by the voice")
+	ErrInvalidSampleRate  = errors.New("invalid sample rate")
+)
+
+// ValidationError is the list of all problems found at once
+type ValidationError []error
+
+func (e ValidationError) Error() string {
+	messages := make([]string, 0, len(e))
+
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any of the problems matches the target
+func (e ValidationError) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// orNil returns nil when there are no problems
+func (e ValidationError) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Validate checks the combination of the options against the voice catalog,
+// all problems are returned at once as ValidationError
+func (r Request) Validate() error {
+	var problems ValidationError
+
+	// the audio template is synthesized by the zsl model, the voice options are not sent
+	if v := VoiceName(r.Voice); r.Voice != "" && r.AudioTemplate == nil {
+		switch {
+		case !v.Known():
+			problems = append(problems, fmt.Errorf("%w %q", ErrUnknownVoice, r.Voice))
+		case r.Language != "" && !v.Speaks(r.Language):
+			problems = append(problems, fmt.Errorf("%w: %s does not speak %s", ErrVoiceLanguage, r.Voice, r.Language))
+		}
+
+		if v.Known() && !v.HasRole(emotion(r.Emotion)) {
+			problems = append(problems, fmt.Errorf("%w: %s has no %s role", ErrUnsupportedEmotion, r.Voice, r.Emotion))
+		}
+	}
+
+	switch r.OutputFormat {
+	case OutputFormatLPCM:
+		switch r.SampleRate {
+		case int(OutputSampleRate8k), int(OutputSampleRate16k), lpcmSampleRate22k, int(OutputSampleRate48k):
+		default:
+			problems = append(problems, fmt.Errorf("%w %d for lpcm", ErrInvalidSampleRate, r.SampleRate))
+		}
+	case OutputFormatULaw, OutputFormatALaw:
+		if r.SampleRate != 0 && r.SampleRate != int(OutputSampleRate8k) {
+			problems = append(problems, fmt.Errorf("%w %d for %s", ErrInvalidSampleRate, r.SampleRate, r.OutputFormat))
+		}
+	case OutputFormatWav, OutputFormatOggOpus, OutputFormatMp3:
+		// the container formats ignore the sample rate
+	case "":
+		problems = append(problems, ErrOutputFormatNotSpecified)
+	default:
+		problems = append(problems, fmt.Errorf("%w %q", ErrInvalidOutputFormat, r.OutputFormat))
+	}
+
+	if err := r.validateVolume(); err != nil {
+		problems = append(problems, err)
+	}
+
+	return problems.orNil()
+}
+
+// validateVolume checks the volume range of the normalization type, the API normalizes by LUFS by default
+func (r Request) validateVolume() error {
+	switch r.LoudnessNormalization {
+	case LoudnessNormalizationMaxPeak:
+		if r.Volume < 0 || r.Volume > 1 {
+			return fmt.Errorf("%w %g, max peak volume must be in (0;1] or 0 for the default", ErrInvalidVolume, r.Volume)
+		}
+	case "", LoudnessNormalizationLUFS:
+		if r.Volume < -145 || r.Volume > 0 {
+			return fmt.Errorf("%w %g, lufs volume must be in [-145;0) or 0 for the default", ErrInvalidVolume, r.Volume)
+		}
+	default:
+		return fmt.Errorf("%w %q", ErrInvalidLoudness, r.LoudnessNormalization)
+	}
+
+	return nil
+}
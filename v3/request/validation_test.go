@@ -0,0 +1,133 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		errs    []error
+	}{
+		{
+			name:    "valid lpcm request",
+			options: []Option{Voice(VoiceMarina), Language(LangRu), Emotion(EmotionWhisper), OutputFormat(OutputFormatLPCM), SampleRate(OutputSampleRate16k)},
+		},
+		{
+			name:    "container format ignores sample rate",
+			options: []Option{Voice(VoiceJohn), OutputFormat(OutputFormatMp3), SampleRate(12345)},
+		},
+		{
+			name:    "g711 at 8k",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatULaw), SampleRate(OutputSampleRate8k)},
+		},
+		{
+			name:    "g711 at 16k",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatALaw), SampleRate(OutputSampleRate16k)},
+			errs:    []error{ErrInvalidSampleRate},
+		},
+		{
+			name:    "all problems at once",
+			options: []Option{Voice(VoiceJohn), Language(LangRu), Emotion(EmotionEvil), OutputFormat(OutputFormatLPCM), SampleRate(12345)},
+			errs:    []error{ErrVoiceLanguage, ErrUnsupportedEmotion, ErrInvalidSampleRate},
+		},
+		{
+			name:    "unknown voice and format",
+			options: []Option{Voice("nobody"), OutputFormat("flac")},
+			errs:    []error{ErrUnknownVoice, ErrInvalidOutputFormat},
+		},
+		{
+			name:    "max peak volume",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatMp3), LoudnessNormalization(LoudnessNormalizationMaxPeak), Volume(1)},
+		},
+		{
+			name:    "default max peak volume",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatMp3), LoudnessNormalization(LoudnessNormalizationMaxPeak), Volume(0)},
+		},
+		{
+			name:    "lufs volume out of range",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatMp3), LoudnessNormalization(LoudnessNormalizationLUFS), Volume(0.7)},
+			errs:    []error{ErrInvalidVolume},
+		},
+		{
+			name:    "default lufs volume out of range",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatMp3), Volume(-150)},
+			errs:    []error{ErrInvalidVolume},
+		},
+		{
+			name:    "max peak volume out of range",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatMp3), LoudnessNormalization(LoudnessNormalizationMaxPeak), Volume(-19)},
+			errs:    []error{ErrInvalidVolume},
+		},
+		{
+			name:    "unknown normalization",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatMp3), LoudnessNormalization("rms")},
+			errs:    []error{ErrInvalidLoudness},
+		},
+		{
+			name:    "lpcm without sample rate",
+			options: []Option{Voice(VoiceAlena), OutputFormat(OutputFormatLPCM)},
+			errs:    []error{ErrInvalidSampleRate},
+		},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			r := NewRequest()
+
+			for _, option := range entry.options {
+				_ = option(r)
+			}
+
+			err := r.Validate()
+
+			var problems ValidationError
+
+			if len(entry.errs) == 0 && err != nil {
+				t.Errorf("got %v", err)
+			} else if len(entry.errs) != 0 && (!errors.As(err, &problems) || len(problems) != len(entry.errs)) {
+				t.Errorf("got %v, want %v", err, entry.errs)
+			}
+
+			for _, want := range entry.errs {
+				if !errors.Is(err, want) {
+					t.Errorf("%v must contain %v", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRequest_ValidateAudioTemplate(t *testing.T) {
+	r := NewRequest()
+	r.Voice = "nobody"
+	r.OutputFormat = OutputFormatOggOpus
+	r.AudioTemplate = &audioTemplate{}
+
+	if err := r.Validate(); err != nil {
+		t.Errorf("voice of the audio template must not be checked, got %v", err)
+	}
+}
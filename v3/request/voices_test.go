@@ -0,0 +1,86 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package request
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVoices(t *testing.T) {
+	voices := Voices()
+
+	if len(voices) != 28 || voices[0] != VoiceAlena {
+		t.Errorf("got %v", voices)
+	}
+
+	for _, v := range voices {
+		if !v.SupportedIn(APIVersion3) || len(v.Languages()) == 0 || v.Gender() == "" {
+			t.Errorf("%s must be a v3 voice with the languages and the gender", v)
+		}
+	}
+}
+
+func TestVoicesFor(t *testing.T) {
+	if got := VoicesFor(LangUZ); !reflect.DeepEqual(got, []VoiceName{VoiceNigora, VoiceYulduz, VoiceZamira}) {
+		t.Errorf("got %v", got)
+	}
+
+	if got := VoicesFor("fr-FR"); len(got) != 0 {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestVoiceMetadata(t *testing.T) {
+	tests := []struct {
+		name   string
+		voice  VoiceName
+		gender gender
+		roles  []emotion
+		v1     bool
+	}{
+		{name: "marina", voice: VoiceMarina, gender: GenderFemale, roles: []emotion{EmotionNeutral, EmotionWhisper, EmotionFriendly}},
+		{name: "jane", voice: VoiceJane, gender: GenderFemale, roles: []emotion{EmotionNeutral, EmotionGood, EmotionEvil}, v1: true},
+		{name: "john", voice: VoiceJohn, gender: GenderMale, v1: true},
+		{name: "unknown", voice: "unknown"},
+	}
+
+	for _, entry := range tests {
+		t.Run(entry.name, func(t *testing.T) {
+			if entry.voice.Known() != (entry.gender != "") || entry.voice.Gender() != entry.gender {
+				t.Errorf("got gender %q", entry.voice.Gender())
+			}
+
+			if !reflect.DeepEqual(entry.voice.Roles(), entry.roles) {
+				t.Errorf("got roles %v", entry.voice.Roles())
+			}
+
+			if entry.voice.SupportedIn(APIVersion1) != entry.v1 {
+				t.Errorf("v1 support must be %t", entry.v1)
+			}
+		})
+	}
+
+	if !VoiceKirill.HasRole(EmotionStrict) || VoiceKirill.HasRole(EmotionWhisper) || !VoiceJohn.HasRole(EmotionNone) {
+		t.Error("roles must be checked against the catalog")
+	}
+}
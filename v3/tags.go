@@ -0,0 +1,70 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/audio/oggopus"
+	"github.com/lEx0/yatts/v3/audio/mp3"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// WithTags writes the tags into the saved .mp3 file as the ID3v2.4 tag with the chapter frames and into
+// the saved .ogg and .opus file as the vorbis comments of its OpusTags header, the other files can not be tagged
+func WithTags(tags audio.Tags) FileOption {
+	return func(path string) error {
+		switch ext := strings.ToLower(filepath.Ext(path)); ext {
+		case ".mp3":
+			return rewriteFile(path, func(w io.Writer, r io.Reader) error {
+				return mp3.SetTags(w, r, tags)
+			})
+		case ".ogg", ".opus":
+			return rewriteFile(path, func(w io.Writer, r io.Reader) error {
+				return oggopus.SetTags(w, r, tags)
+			})
+		default:
+			return fmt.Errorf("%w %q", ErrUntaggableFile, ext)
+		}
+	}
+}
+
+// rewriteFile replaces the content of the file with the transformed one
+func rewriteFile(path string, transform func(w io.Writer, r io.Reader) error) error {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	if err = transform(&buf, bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0666)
+}
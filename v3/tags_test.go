@@ -0,0 +1,97 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/v3/audio/mp3"
+	"github.com/lEx0/yatts/v3/auth"
+	"github.com/lEx0/yatts/v3/request"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestYaTTS_SaveFileWith(t *testing.T) {
+	// the 128 kbit/s 44100 Hz mono layer III frame
+	frame := append([]byte{0xff, 0xfb, 0x90, 0xc0}, make([]byte, 413)...)
+	client := &YaTTS{
+		auth:    auth.NewAPITokenAuth("token", ""),
+		options: []request.Option{request.Voice(request.VoiceAlena)},
+		client: fakeSynthesizer{audio: func(req *tts.UtteranceSynthesisRequest) [][]byte {
+			return [][]byte{bytes.Repeat(frame, 10)}
+		}},
+	}
+
+	dir, err := ioutil.TempDir("", "yatts")
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	var (
+		path = filepath.Join(dir, "chapter.mp3")
+		tags = []FileOption{WithTags(audio.Tags{Title: "Глава 1", Artist: "alena"})}
+	)
+
+	if err = client.SaveFileWith(context.Background(), path, request.SimpleTextEntity{Text: "Привет"}, tags); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	defer func() { _ = f.Close() }()
+
+	scanner := mp3.NewScanner(f)
+
+	if _, err = scanner.Next(); err != nil {
+		t.Error(err)
+	}
+
+	if tag := scanner.Tag(); !bytes.Contains(tag, []byte("TIT2")) || !bytes.Contains(tag, []byte("alena")) {
+		t.Errorf("got tag %q", tag)
+	}
+
+	path = filepath.Join(dir, "chapter.wav")
+
+	if err = client.SaveFileWith(context.Background(), path, request.SimpleTextEntity{Text: "Привет"}, tags); !errors.Is(err, ErrUntaggableFile) {
+		t.Errorf("got %v", err)
+	}
+
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("the untagged file is kept")
+	}
+}
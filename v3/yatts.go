@@ -24,6 +24,7 @@ package yatts
 import (
 	"context"
 	"crypto/tls"
+	"github.com/lEx0/yatts/audio"
 	"github.com/lEx0/yatts/v3/auth"
 	"github.com/lEx0/yatts/v3/request"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/ai/tts/v3"
@@ -40,10 +41,17 @@ type (
 
 	// YaTTS is implementation of TTS based on Yandex TTS
 	YaTTS struct {
-		auth     auth.Authable
-		endpoint string
-		options  []request.Option
-		client   tts.SynthesizerClient
+		auth      auth.Authable
+		endpoint  string
+		options   []request.Option
+		client    tts.SynthesizerClient
+		templates *request.AudioTemplateRegistry
+	}
+
+	// readCloser reads the wrapped stream and closes the synthesized stream
+	readCloser struct {
+		io.Reader
+		io.Closer
 	}
 )
 
@@ -83,20 +91,41 @@ func (y *YaTTS) SetTTSEndpointURL(url string) {
 
 // Speak sends a request to the TTS endpoint and receives an audio stream.
 func (y *YaTTS) Speak(ctx context.Context, entity request.TextEntity, options ...request.Option) (io.Reader, error) {
-	req, err := y.buildRequest(entity, options...)
+	stream, _, err := y.speak(ctx, entity, options...)
+
+	return stream, err
+}
+
+// speak sends the request of the entity and returns the audio stream with the G.711 codec
+// it is encoded with locally, the codec is zero when the stream is returned as it is synthesized,
+// closing the stream stops the synthesis
+func (y *YaTTS) speak(
+	ctx context.Context,
+	entity request.TextEntity,
+	options ...request.Option,
+) (io.ReadCloser, audio.G711, error) {
+	_, sent, codec, err := y.resolve(entity, options...)
 
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	authCtx, err := y.auth.Auth(ctx)
+	stream, err := y.synthesize(ctx, sent, codec)
+
+	return stream, codec, err
+}
+
+// synthesize sends the request and streams the audio encoded with the codec,
+// closing the stream stops the synthesis
+func (y *YaTTS) synthesize(ctx context.Context, sent *request.Request, codec audio.G711) (io.ReadCloser, error) {
+	req, err := sent.Build()
 
 	if err != nil {
 		return nil, err
 	}
 
-	cctx, cancel := context.WithCancel(authCtx)
-	client, err := y.client.UtteranceSynthesis(cctx, req)
+	cctx, cancel := context.WithCancel(ctx)
+	client, err := y.utterance(cctx, req)
 
 	if err != nil {
 		cancel()
@@ -129,22 +158,79 @@ func (y *YaTTS) Speak(ctx context.Context, entity request.TextEntity, options ..
 		}
 	}()
 
+	if codec != 0 {
+		return readCloser{Reader: audio.NewG711Encoder(pr, codec), Closer: pr}, nil
+	}
+
 	return pr, nil
 }
 
-// build tts.UtteranceSynthesisRequest to Yandex TTS API
-func (y *YaTTS) buildRequest(entity request.TextEntity, options ...request.Option) (*tts.UtteranceSynthesisRequest, error) {
+// utterance authorizes the context and starts the synthesis
+func (y *YaTTS) utterance(
+	ctx context.Context,
+	req *tts.UtteranceSynthesisRequest,
+) (tts.Synthesizer_UtteranceSynthesisClient, error) {
+	authCtx, err := y.auth.Auth(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return y.client.UtteranceSynthesis(authCtx, req)
+}
+
+// Resolve returns the validated request of the entity with the requested format,
+// the G.711 formats are not replaced by the lpcm sent to the API, nothing is sent
+func (y *YaTTS) Resolve(entity request.TextEntity, options ...request.Option) (*request.Request, error) {
+	r, _, _, err := y.resolve(entity, options...)
+
+	return r, err
+}
+
+// resolve returns the validated request as it was asked for and the request sent to the API,
+// the G.711 output formats are switched to the 8 kHz lpcm in the sent request
+// and returned as the codec the lpcm has to be encoded with
+func (y *YaTTS) resolve(
+	entity request.TextEntity,
+	options ...request.Option,
+) (*request.Request, *request.Request, audio.G711, error) {
 	r := request.NewRequest()
 
-	for _, option := range append(y.options, options...) {
+	for _, option := range appendOptions(y.options, options...) {
 		if err := option(r); err != nil {
-			return nil, err
+			return nil, nil, 0, err
 		}
 	}
 
 	if err := entity.Process(r); err != nil {
-		return nil, err
+		return nil, nil, 0, err
+	} else if err = r.Validate(); err != nil {
+		return nil, nil, 0, err
+	}
+
+	sent, codec := convert(r)
+
+	return r, sent, codec, nil
+}
+
+// appendOptions returns the options followed by the extra ones in a new slice,
+// the spare capacity of the options is shared by the concurrent calls and is never written
+func appendOptions(options []request.Option, extra ...request.Option) []request.Option {
+	result := make([]request.Option, 0, len(options)+len(extra))
+
+	return append(append(result, options...), extra...)
+}
+
+// convert returns the request sent to the API for the requested one,
+// the G.711 output formats are switched to the 8 kHz lpcm encoded locally with the returned codec
+func convert(r *request.Request) (*request.Request, audio.G711) {
+	sent := *r
+	codec, ok := g711Formats[string(r.OutputFormat)]
+
+	if ok {
+		sent.OutputFormat = request.OutputFormatLPCM
+		sent.SampleRate = audio.G711SampleRate
 	}
 
-	return r.Build()
+	return &sent, codec
 }
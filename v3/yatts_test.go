@@ -0,0 +1,107 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"errors"
+	"github.com/lEx0/yatts/v3/auth"
+	"github.com/lEx0/yatts/v3/request"
+	"testing"
+)
+
+func TestYaTTS_Resolve(t *testing.T) {
+	client := &YaTTS{
+		auth:    auth.NewAPITokenAuth("token", ""),
+		options: []request.Option{request.Voice(request.VoiceAlena), request.OutputFormat(request.OutputFormatMp3)},
+	}
+
+	r, err := client.Resolve(request.SimpleTextEntity{Text: "Привет"}, request.Emotion(request.EmotionGood))
+
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if r.GetVoice() != "alena" || r.GetEmotion() != "good" || r.GetOutputFormat() != request.OutputFormatMp3 {
+		t.Errorf("got %s", r.Describe())
+	}
+
+	if _, err = client.Resolve(request.SimpleTextEntity{Text: "Привет"}, request.Emotion(request.EmotionWhisper)); !errors.Is(err, request.ErrUnsupportedEmotion) {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestYaTTS_ResolveSharedOptions(t *testing.T) {
+	options := make([]request.Option, 2, 3)
+	options[0], options[1] = request.Voice(request.VoiceAlena), request.OutputFormat(request.OutputFormatMp3)
+	client := &YaTTS{auth: auth.NewAPITokenAuth("token", ""), options: options}
+
+	if _, err := client.Resolve(request.SimpleTextEntity{Text: "Привет"}, request.Emotion(request.EmotionGood)); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	if options[:3][2] != nil {
+		t.Error("the shared options are overwritten")
+	}
+}
+
+func TestYaTTS_ResolveG711(t *testing.T) {
+	client := &YaTTS{
+		auth:    auth.NewAPITokenAuth("token", ""),
+		options: []request.Option{request.Voice(request.VoiceAlena)},
+	}
+
+	keys := map[string]bool{}
+
+	for _, format := range []request.Option{
+		request.OutputFormat(request.OutputFormatULaw),
+		request.OutputFormat(request.OutputFormatALaw),
+		request.OutputFormat(request.OutputFormatLPCM),
+	} {
+		r, err := client.Resolve(request.SimpleTextEntity{Text: "Привет"}, format, request.SampleRate(request.OutputSampleRate8k))
+
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		key, err := r.CacheKey()
+
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+
+		keys[key] = true
+	}
+
+	if len(keys) != 3 {
+		t.Error("G.711 and lpcm requests must have different cache keys")
+	}
+
+	r, _ := client.Resolve(request.SimpleTextEntity{Text: "Привет"}, request.OutputFormat(request.OutputFormatULaw))
+
+	if r.GetOutputFormat() != request.OutputFormatULaw {
+		t.Errorf("requested format must be kept, got %s", r.GetOutputFormat())
+	}
+}
@@ -0,0 +1,90 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"context"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/request"
+	"io"
+)
+
+// SpeakWAV synthesizes the lpcm and returns it in the wav container with the streaming sizes,
+// the G.711 output formats are kept and wrapped as they are
+func (y *YaTTS) SpeakWAV(ctx context.Context, entity request.TextEntity, options ...request.Option) (io.ReadCloser, error) {
+	body, format, err := y.speakWAV(ctx, entity, options...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return readCloser{Reader: audio.NewWAVFormatReader(body, format), Closer: body}, nil
+}
+
+// WriteWAV synthesizes the lpcm into w wrapped into the wav container,
+// the sizes are patched when w is seekable, returns the number of the samples bytes
+func (y *YaTTS) WriteWAV(
+	ctx context.Context,
+	w io.Writer,
+	entity request.TextEntity,
+	options ...request.Option,
+) (int64, error) {
+	body, format, err := y.speakWAV(ctx, entity, options...)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() { _ = body.Close() }()
+
+	wav, err := audio.NewWAVFormatWriter(w, format)
+
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(wav, body)
+
+	if err != nil {
+		return n, err
+	}
+
+	return n, wav.Close()
+}
+
+// speakWAV synthesizes the samples wrapped into the wav container and returns them with their format
+func (y *YaTTS) speakWAV(
+	ctx context.Context,
+	entity request.TextEntity,
+	options ...request.Option,
+) (io.ReadCloser, audio.WAVFormat, error) {
+	return y.speak(ctx, entity, appendOptions(options, wavOutputFormat)...)
+}
+
+// wavOutputFormat keeps the G.711 output formats and switches the others to the lpcm
+func wavOutputFormat(req *request.Request) error {
+	if _, ok := g711Formats[req.OutputFormat]; !ok {
+		req.OutputFormat = string(request.OutputFormatLPCM)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/lEx0/yatts/audio"
+	"github.com/lEx0/yatts/auth"
+	"github.com/lEx0/yatts/request"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+)
+
+func newLPCMServer(t *testing.T, size int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		form, err := url.ParseQuery(string(body))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "lpcm", form.Get("format"))
+
+		_, _ = w.Write(bytes.Repeat([]byte{1}, size))
+	}))
+}
+
+func TestYaTTS_SpeakWAV(t *testing.T) {
+	server := newLPCMServer(t, 1600)
+	defer server.Close()
+
+	client := NewYaTTS(auth.NewAPITokenAuth("token"), server.Client())
+	client.SetTTSEndpointURL(server.URL)
+
+	stream, err := client.SpeakWAV(context.Background(), request.SimpleTextEntity{Text: "Привет"})
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Close())
+
+	assert.Len(t, data, audio.WAVHeaderSize+1600)
+	assert.Equal(t, uint32(request.DefaultSampleRate), binary.LittleEndian.Uint32(data[24:]))
+}
+
+func TestYaTTS_WriteWAV(t *testing.T) {
+	server := newLPCMServer(t, 1600)
+	defer server.Close()
+
+	client := NewYaTTS(auth.NewAPITokenAuth("token"), server.Client(), request.SampleRate(request.OutputSampleRate8k))
+	client.SetTTSEndpointURL(server.URL)
+
+	f, err := ioutil.TempFile("", "yatts-*.wav")
+	assert.NoError(t, err)
+
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	n, err := client.WriteWAV(context.Background(), f, request.SimpleTextEntity{Text: "Привет"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1600), n)
+	assert.NoError(t, f.Close())
+
+	data, err := ioutil.ReadFile(f.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, audio.PCMFormat(8000).Header(1600), data[:audio.WAVHeaderSize])
+}
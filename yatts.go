@@ -24,6 +24,7 @@ package yatts
 import (
 	"context"
 	"fmt"
+	"github.com/lEx0/yatts/audio"
 	"github.com/lEx0/yatts/auth"
 	"github.com/lEx0/yatts/request"
 	"io"
@@ -73,34 +74,119 @@ func (y *YaTTS) SetTTSEndpointURL(url string) {
 
 // Speak sends a request to the TTS endpoint and receives an audio stream.
 func (y *YaTTS) Speak(ctx context.Context, entity request.TextEntity, options ...request.Option) (io.ReadCloser, error) {
-	if req, err := y.buildRequest(ctx, entity, options...); err != nil {
-		return nil, err
-	} else if resp, err := y.client.Do(req); err != nil {
+	body, _, err := y.speak(ctx, entity, options...)
+
+	return body, err
+}
+
+// speak sends the request of the entity and returns the audio stream with the wav format
+// of the stream, the lpcm is converted locally when the requested format is not offered by the API
+func (y *YaTTS) speak(
+	ctx context.Context,
+	entity request.TextEntity,
+	options ...request.Option,
+) (io.ReadCloser, audio.WAVFormat, error) {
+	_, sent, conv, err := y.resolve(entity, options...)
+
+	if err != nil {
+		return nil, audio.WAVFormat{}, err
+	}
+
+	return y.synthesize(ctx, sent, conv)
+}
+
+// synthesize sends the request and converts the response to the requested format
+func (y *YaTTS) synthesize(
+	ctx context.Context,
+	sent *request.Request,
+	conv conversion,
+) (io.ReadCloser, audio.WAVFormat, error) {
+	body, err := y.speakRequest(ctx, sent)
+
+	if err != nil {
+		return nil, audio.WAVFormat{}, err
+	}
+
+	return conv.apply(body, sent.SampleRate)
+}
+
+// do sends the request and returns the response body
+func (y *YaTTS) do(req *http.Request) (io.ReadCloser, error) {
+	if resp, err := y.client.Do(req); err != nil {
 		return nil, err
 	} else if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+
 		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	} else {
 		return resp.Body, nil
 	}
 }
 
-// build http.Request to Yandex TTS API
-func (y *YaTTS) buildRequest(ctx context.Context, entity request.TextEntity, options ...request.Option) (*http.Request, error) {
-	r := request.NewRequest()
+// Resolve returns the validated request of the entity with the requested format and sample rate,
+// the format converted locally is not replaced by the one sent to the API, nothing is sent
+func (y *YaTTS) Resolve(entity request.TextEntity, options ...request.Option) (*request.Request, error) {
+	r, _, _, err := y.resolve(entity, options...)
+
+	return r, err
+}
+
+// resolve returns the validated request as it was asked for, the request sent to the API
+// and the local conversion of the response to the requested format
+func (y *YaTTS) resolve(
+	entity request.TextEntity,
+	options ...request.Option,
+) (*request.Request, *request.Request, conversion, error) {
+	var (
+		r    = request.NewRequest()
+		conv conversion
+	)
 
-	for _, option := range append(y.options, options...) {
+	for _, option := range appendOptions(y.options, options...) {
 		if err := option(r); err != nil {
-			return nil, err
+			return nil, nil, conv, err
 		}
 	}
 
 	if err := entity.Process(r); err != nil {
+		return nil, nil, conv, err
+	} else if err := r.Validate(); err != nil {
+		return nil, nil, conv, err
+	}
+
+	sent, conv := convert(r)
+
+	return r, sent, conv, nil
+}
+
+// appendOptions returns the options followed by the extra ones in a new slice,
+// the spare capacity of the options is shared by the concurrent calls and is never written
+func appendOptions(options []request.Option, extra ...request.Option) []request.Option {
+	result := make([]request.Option, 0, len(options)+len(extra))
+
+	return append(append(result, options...), extra...)
+}
+
+// speakRequest sends the resolved request and returns the response body
+func (y *YaTTS) speakRequest(ctx context.Context, r *request.Request) (io.ReadCloser, error) {
+	body, err := r.Body()
+
+	if err != nil {
 		return nil, err
 	}
 
-	if body, err := r.Body(); err != nil {
+	req, err := y.newHTTPRequest(ctx, body)
+
+	if err != nil {
 		return nil, err
-	} else if req, err := http.NewRequestWithContext(
+	}
+
+	return y.do(req)
+}
+
+// newHTTPRequest creates the authorized http.Request with the form body
+func (y *YaTTS) newHTTPRequest(ctx context.Context, body io.Reader) (*http.Request, error) {
+	if req, err := http.NewRequestWithContext(
 		ctx, http.MethodPost, y.url, body,
 	); err != nil {
 		return nil, err
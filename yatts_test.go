@@ -0,0 +1,112 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2026 Amangeldy Kadyl
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package yatts
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/lEx0/yatts/auth"
+	"github.com/lEx0/yatts/request"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestYaTTS_Resolve(t *testing.T) {
+	client := NewYaTTS(
+		auth.NewAPITokenAuth("token"),
+		nil,
+		request.Voice(request.VoiceAlena),
+		request.OutputFormat(request.OutputFormatLPCM),
+	)
+
+	r, err := client.Resolve(
+		request.SimpleTextEntity{Text: "Привет"},
+		request.Emotion(request.EmotionGood),
+		request.SampleRate(request.OutputSampleRate8k),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "alena", r.GetVoice())
+	assert.Equal(t, "good", r.GetEmotion())
+	assert.Equal(t, 8000, r.GetSampleRate())
+	assert.Equal(t, "Привет", r.GetText())
+
+	_, err = client.Resolve(request.SimpleTextEntity{Text: "Привет"}, request.Emotion(request.EmotionEvil))
+	assert.ErrorIs(t, err, request.ErrUnsupportedEmotion)
+}
+
+func TestYaTTS_ResolveSharedOptions(t *testing.T) {
+	options := make([]request.Option, 1, 2)
+	options[0] = request.Voice(request.VoiceAlena)
+	client := NewYaTTS(auth.NewAPITokenAuth("token"), nil, options...)
+
+	_, err := client.Resolve(request.SimpleTextEntity{Text: "Привет"}, request.Emotion(request.EmotionGood))
+	assert.NoError(t, err)
+	assert.Nil(t, options[:2][1])
+}
+
+func TestYaTTS_Resample(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		form, err := url.ParseQuery(string(body))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "48000", form.Get("sampleRateHertz"))
+
+		_, _ = w.Write(make([]byte, 96000))
+	}))
+	defer server.Close()
+
+	client := NewYaTTS(
+		auth.NewAPITokenAuth("token"),
+		server.Client(),
+		request.OutputFormat(request.OutputFormatLPCM),
+		request.SampleRate(22050),
+	)
+	client.SetTTSEndpointURL(server.URL)
+
+	_, err := client.Speak(context.Background(), request.SimpleTextEntity{Text: "Привет"})
+	assert.ErrorIs(t, err, request.ErrInvalidSampleRate)
+
+	r, err := client.Resolve(request.SimpleTextEntity{Text: "Привет"}, request.Resample(true))
+	assert.NoError(t, err)
+	assert.Equal(t, 22050, r.GetSampleRate())
+
+	stream, err := client.Speak(context.Background(), request.SimpleTextEntity{Text: "Привет"}, request.Resample(true))
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Close())
+	assert.Len(t, data, 44100)
+
+	wav, err := client.SpeakWAV(context.Background(), request.SimpleTextEntity{Text: "Привет"}, request.Resample(true))
+	assert.NoError(t, err)
+
+	data, err = ioutil.ReadAll(wav)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(22050), binary.LittleEndian.Uint32(data[24:]))
+}